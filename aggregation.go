@@ -0,0 +1,182 @@
+package turf
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+// CountPointsInPolygon counts the points that reside inside each polygon of the collection and writes
+// the count to the countProperty of a copy of the polygon feature.
+// The polygons can be Polygons or MultiPolygons and the points must be Point features.
+//
+// Examples:
+//
+//	fc, err := turf.CountPointsInPolygon(zones, stores, "stores")
+func CountPointsInPolygon(polygons *feature.Collection, points *feature.Collection, countProperty string) (*feature.Collection, error) {
+	within, err := pointsInPolygons(polygons, points)
+	if err != nil {
+		return nil, err
+	}
+
+	res := common.CloneCollection(polygons)
+	for i := range res.Features {
+		setProperty(&res.Features[i], countProperty, len(within[i]))
+	}
+	return res, nil
+}
+
+// Collect gathers the values of the inProperty of the points that reside inside each polygon of the collection.
+// A copy of every polygon feature gets the list of values in the outProperty, the number of points inside it in the
+// outProperty followed by "_count" and the statistics of the numeric values in the outProperty followed by "_sum", "_mean",
+// "_median", "_min" and "_max". The mean, median, min and max are nil for a polygon without numeric values.
+// Points without the inProperty are counted but their values are skipped.
+// The polygons can be Polygons or MultiPolygons and the points must be Point features.
+//
+// Examples:
+//
+//	fc, err := turf.Collect(zones, sales, "amount", "amounts")
+//	total := fc.Features[0].Properties["amounts_sum"]
+func Collect(polygons *feature.Collection, points *feature.Collection, inProperty string, outProperty string) (*feature.Collection, error) {
+	within, err := pointsInPolygons(polygons, points)
+	if err != nil {
+		return nil, err
+	}
+
+	res := common.CloneCollection(polygons)
+	for i := range res.Features {
+		values := []interface{}{}
+		numbers := []float64{}
+		for _, j := range within[i] {
+			v, ok := points.Features[j].Properties[inProperty]
+			if !ok {
+				continue
+			}
+			values = append(values, v)
+			if n, ok := common.ToFloat(v); ok {
+				numbers = append(numbers, n)
+			}
+		}
+
+		f := &res.Features[i]
+		setProperty(f, outProperty, values)
+		setProperty(f, outProperty+"_count", len(within[i]))
+		for k, v := range statistics(numbers) {
+			setProperty(f, outProperty+"_"+k, v)
+		}
+	}
+	return res, nil
+}
+
+// pointsInPolygons returns for every polygon feature the indices of the point features inside it.
+// The points are sorted by longitude so that only the points within the bbox of a polygon are tested.
+func pointsInPolygons(polygons *feature.Collection, points *feature.Collection) ([][]int, error) {
+	if polygons == nil || points == nil {
+		return nil, errors.New("polygons and points are required")
+	}
+
+	pts := make([]geometry.Point, len(points.Features))
+	for i := range points.Features {
+		p, err := points.Features[i].ToPoint()
+		if err != nil {
+			return nil, errors.New("points must be Point features")
+		}
+		pts[i] = *p
+	}
+	order := make([]int, len(pts))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return pts[order[i]].Lng < pts[order[j]].Lng
+	})
+
+	res := make([][]int, len(polygons.Features))
+	for i := range polygons.Features {
+		polys, err := common.Polygons(&polygons.Features[i])
+		if err != nil {
+			return nil, err
+		}
+		mp := geometry.MultiPolygon{Coordinates: polys}
+		bbox := polygonsBBox(polys)
+
+		within := []int{}
+		start := sort.Search(len(order), func(k int) bool {
+			return pts[order[k]].Lng >= bbox.West
+		})
+		for k := start; k < len(order) && pts[order[k]].Lng <= bbox.East; k++ {
+			p := pts[order[k]]
+			if p.Lat < bbox.South || p.Lat > bbox.North {
+				continue
+			}
+			if PointInMultiPolygon(p, mp) {
+				within = append(within, order[k])
+			}
+		}
+		sort.Ints(within)
+		res[i] = within
+	}
+	return res, nil
+}
+
+// polygonsBBox returns the bbox of the outer rings of the polygons.
+func polygonsBBox(polys []geometry.Polygon) geojson.BBOX {
+	b := geojson.BBOX{West: math.Inf(1), South: math.Inf(1), East: math.Inf(-1), North: math.Inf(-1)}
+	for _, p := range polys {
+		if len(p.Coordinates) == 0 {
+			continue
+		}
+		for _, c := range p.Coordinates[0].Coordinates {
+			b.West = math.Min(b.West, c.Lng)
+			b.South = math.Min(b.South, c.Lat)
+			b.East = math.Max(b.East, c.Lng)
+			b.North = math.Max(b.North, c.Lat)
+		}
+	}
+	return b
+}
+
+// statistics returns the sum, mean, median, min and max of the values.
+func statistics(values []float64) map[string]interface{} {
+	stats := map[string]interface{}{
+		"sum":    0.0,
+		"mean":   nil,
+		"median": nil,
+		"min":    nil,
+		"max":    nil,
+	}
+	if len(values) == 0 {
+		return stats
+	}
+
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	n := len(sorted)
+	median := sorted[n/2]
+	if n%2 == 0 {
+		median = (sorted[n/2-1] + sorted[n/2]) / 2
+	}
+
+	stats["sum"] = sum
+	stats["mean"] = sum / float64(n)
+	stats["median"] = median
+	stats["min"] = sorted[0]
+	stats["max"] = sorted[n-1]
+	return stats
+}
+
+func setProperty(f *feature.Feature, name string, value interface{}) {
+	if f.Properties == nil {
+		f.Properties = map[string]interface{}{}
+	}
+	f.Properties[name] = value
+}
@@ -0,0 +1,108 @@
+package turf
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/turf-go/assert"
+)
+
+func aggregationFixtures(t *testing.T) (*feature.Collection, *feature.Collection) {
+	polygons, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"a\" }, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]], [[4, 4], [6, 4], [6, 6], [4, 6], [4, 4]]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": null, \"geometry\": { \"type\": \"MultiPolygon\", \"coordinates\": [[[[20, 0], [30, 0], [30, 10], [20, 0]]], [[[40, 40], [50, 40], [50, 50], [40, 40]]]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"c\" }, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[-10, -10], [-5, -10], [-5, -5], [-10, -10]]] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	points, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"value\": 5 }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [1, 1] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"value\": 1 }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [5, 5] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"value\": 2 }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [9, 2] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"value\": \"n/a\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [2, 8] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"value\": 10 }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [29, 1] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Point\", \"coordinates\": [49, 41] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"value\": 20 }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [100, 1] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	return polygons, points
+}
+
+func TestCountPointsInPolygon(t *testing.T) {
+	polygons, points := aggregationFixtures(t)
+	res, err := CountPointsInPolygon(polygons, points, "count")
+	if err != nil {
+		t.Errorf("CountPointsInPolygon error %v", err)
+	}
+	assert.Equal(t, res.Features[0].Properties, map[string]interface{}{"name": "a", "count": 3})
+	assert.Equal(t, res.Features[1].Properties, map[string]interface{}{"count": 2})
+	assert.Equal(t, res.Features[2].Properties["count"], 0)
+	// the input polygons are left untouched
+	assert.Equal(t, len(polygons.Features[0].Properties), 1)
+}
+
+func TestCollect(t *testing.T) {
+	polygons, points := aggregationFixtures(t)
+	res, err := Collect(polygons, points, "value", "values")
+	if err != nil {
+		t.Errorf("Collect error %v", err)
+	}
+
+	tests := map[string]struct {
+		index int
+		want  map[string]interface{}
+	}{
+		"mixed values": {
+			index: 0,
+			want: map[string]interface{}{
+				"name":          "a",
+				"values":        []interface{}{5.0, 2.0, "n/a"},
+				"values_count":  3,
+				"values_sum":    7.0,
+				"values_mean":   3.5,
+				"values_median": 3.5,
+				"values_min":    2.0,
+				"values_max":    5.0,
+			},
+		},
+		"missing property": {
+			index: 1,
+			want: map[string]interface{}{
+				"values":        []interface{}{10.0},
+				"values_count":  2,
+				"values_sum":    10.0,
+				"values_mean":   10.0,
+				"values_median": 10.0,
+				"values_min":    10.0,
+				"values_max":    10.0,
+			},
+		},
+		"no points": {
+			index: 2,
+			want: map[string]interface{}{
+				"name":          "c",
+				"values":        []interface{}{},
+				"values_count":  0,
+				"values_sum":    0.0,
+				"values_mean":   nil,
+				"values_median": nil,
+				"values_min":    nil,
+				"values_max":    nil,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, res.Features[tt.index].Properties, tt.want)
+		})
+	}
+}
+
+func TestCollectInvalidPoints(t *testing.T) {
+	polygons, _ := aggregationFixtures(t)
+	_, err := Collect(polygons, polygons, "value", "values")
+	assert.Equal(t, err.Error(), "points must be Point features")
+}
@@ -0,0 +1,37 @@
+package booleans
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// Clockwise takes a ring and returns true if it is oriented clockwise.
+// The ring can be a LineString Feature, Geometry, LineString or a slice of points.
+//
+// Examples:
+//
+//	cw, err := booleans.Clockwise([]geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 1, Lat: 0}, {Lng: 0, Lat: 0}})
+//	// = true
+func Clockwise(t interface{}) (bool, error) {
+	switch gtp := t.(type) {
+	case []geometry.Point:
+		return planar.SignedArea(gtp) < 0, nil
+	case *geometry.LineString:
+		return planar.SignedArea(gtp.Coordinates) < 0, nil
+	case *feature.Feature:
+		return Clockwise(&gtp.Geometry)
+	case *geometry.Geometry:
+		if gtp.GeoJSONType == geojson.LineString {
+			ln, err := gtp.ToLineString()
+			if err != nil {
+				return false, err
+			}
+			return Clockwise(ln)
+		}
+	}
+	return false, errors.New("ring must be a LineString or an Array of points")
+}
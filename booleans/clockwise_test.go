@@ -0,0 +1,35 @@
+package booleans
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+)
+
+func TestClockwise(t *testing.T) {
+	cw := []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 1, Lat: 0}, {Lng: 0, Lat: 0}}
+	ccw := []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}}
+
+	res, err := Clockwise(cw)
+	assert.Nil(t, err)
+	assert.True(t, res)
+
+	res, err = Clockwise(&geometry.LineString{Coordinates: ccw})
+	assert.Nil(t, err)
+	assert.Equal(t, res, false)
+}
+
+func TestClockwiseFeature(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [1, 1], [1, 0], [0, 0]] } }")
+	assert.Nil(t, err)
+	res, err := Clockwise(f)
+	assert.Nil(t, err)
+	assert.True(t, res)
+}
+
+func TestClockwiseInvalidType(t *testing.T) {
+	_, err := Clockwise(&geometry.Point{Lng: 0, Lat: 0})
+	assert.Equal(t, err.Error(), "ring must be a LineString or an Array of points")
+}
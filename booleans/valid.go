@@ -0,0 +1,411 @@
+package booleans
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// Reason describes why a geometry is invalid.
+type Reason string
+
+const (
+	// UnclosedRing is reported when the first and the last position of a ring are not equal.
+	UnclosedRing Reason = "unclosed ring"
+	// TooFewPositions is reported for rings with fewer than 4 positions and lines with fewer than 2 positions.
+	TooFewPositions Reason = "too few positions"
+	// SelfIntersection is reported when a ring intersects itself or crosses another ring of the same polygon.
+	SelfIntersection Reason = "self-intersection"
+	// HoleOutsideShell is reported when a hole is not contained by the outer ring of its polygon.
+	HoleOutsideShell Reason = "hole outside shell"
+	// OverlappingHoles is reported when two holes of the same polygon overlap.
+	OverlappingHoles Reason = "overlapping holes"
+	// NonFiniteCoordinate is reported for NaN or infinite coordinates.
+	NonFiniteCoordinate Reason = "non-finite coordinate"
+	// OutOfRange is reported for longitudes outside [-180, 180] or latitudes outside [-90, 90].
+	OutOfRange Reason = "coordinate out of range"
+)
+
+// ValidationError describes a single problem of a geometry and where it was found.
+// Indices that don't apply to the validated object are -1.
+type ValidationError struct {
+	Reason Reason
+	// Feature is the index of the Feature in a FeatureCollection.
+	Feature int
+	// Geometry is the index of the geometry in a GeometryCollection.
+	Geometry int
+	// Polygon is the index of the polygon in a MultiPolygon.
+	Polygon int
+	// Ring is the index of the ring in a Polygon or of the line in a MultiLineString.
+	Ring int
+	// Position is the index of the position in its ring or line.
+	Position int
+	// Point is the location of the problem when it is known.
+	Point *geometry.Point
+}
+
+func (e ValidationError) Error() string {
+	parts := []string{}
+	for _, v := range []struct {
+		name  string
+		index int
+	}{
+		{"feature", e.Feature},
+		{"geometry", e.Geometry},
+		{"polygon", e.Polygon},
+		{"ring", e.Ring},
+		{"position", e.Position},
+	} {
+		if v.index >= 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", v.name, v.index))
+		}
+	}
+	msg := string(e.Reason)
+	if e.Point != nil {
+		msg = fmt.Sprintf("%s at [%v, %v]", msg, e.Point.Lng, e.Point.Lat)
+	}
+	if len(parts) == 0 {
+		return msg
+	}
+	return fmt.Sprintf("%s: %s", strings.Join(parts, ", "), msg)
+}
+
+// Valid checks if the geometry is a valid GeoJSON geometry according to the OGC Simple Feature Specification.
+// The error is only returned for unsupported input, use Validate to get the reasons for an invalid geometry.
+func Valid(t interface{}) (bool, error) {
+	errs, err := Validate(t)
+	if err != nil {
+		return false, err
+	}
+	return len(errs) == 0, nil
+}
+
+// Validate returns every reason why the geometry is invalid, or an empty list for valid geometries.
+// t can be a FeatureCollection, Feature, Geometry, GeometryCollection or any geometry type.
+//
+// Examples:
+//
+//	errs, err := booleans.Validate(fc)
+//	for _, e := range errs {
+//		fmt.Println(e.Feature, e.Ring, e.Reason)
+//	}
+func Validate(t interface{}) ([]ValidationError, error) {
+	errs := []ValidationError{}
+	switch gtp := t.(type) {
+	case *feature.Collection:
+		for i := range gtp.Features {
+			res, err := validateGeometry(&gtp.Features[i].Geometry)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range res {
+				e.Feature = i
+				errs = append(errs, e)
+			}
+		}
+		return errs, nil
+	case *feature.Feature:
+		return validateGeometry(&gtp.Geometry)
+	case *geometry.Collection:
+		for i := range gtp.Geometries {
+			res, err := validateGeometry(&gtp.Geometries[i])
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range res {
+				e.Geometry = i
+				errs = append(errs, e)
+			}
+		}
+		return errs, nil
+	case *geometry.Geometry:
+		return validateGeometry(gtp)
+	case *geometry.Point:
+		return validatePositions([]geometry.Point{*gtp}, -1, -1), nil
+	case *geometry.MultiPoint:
+		return validatePositions(gtp.Coordinates, -1, -1), nil
+	case *geometry.LineString:
+		return validateLine(gtp.Coordinates, -1), nil
+	case *geometry.MultiLineString:
+		for i, l := range gtp.Coordinates {
+			errs = append(errs, validateLine(l.Coordinates, i)...)
+		}
+		return errs, nil
+	case *geometry.Polygon:
+		return validatePolygon(ringsOf(*gtp), -1), nil
+	case *geometry.MultiPolygon:
+		for i, p := range gtp.Coordinates {
+			errs = append(errs, validatePolygon(ringsOf(p), i)...)
+		}
+		return errs, nil
+	}
+	return nil, errors.New("unsupported geojson type")
+}
+
+func ringsOf(p geometry.Polygon) [][]geometry.Point {
+	rings := [][]geometry.Point{}
+	for _, r := range p.Coordinates {
+		rings = append(rings, r.Coordinates)
+	}
+	return rings
+}
+
+// validateGeometry reads the raw coordinates of the geometry, as the geometry conversions
+// of the geojson package reject some of the invalid geometries.
+func validateGeometry(g *geometry.Geometry) ([]ValidationError, error) {
+	errs := []ValidationError{}
+	if g.GeoJSONType == geojson.GeometryCollection {
+		geoms, err := collectionGeometries(g)
+		if err != nil {
+			return nil, err
+		}
+		for i := range geoms {
+			res, err := validateGeometry(&geoms[i])
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range res {
+				e.Geometry = i
+				errs = append(errs, e)
+			}
+		}
+		return errs, nil
+	}
+
+	coords, err := common.RawCoordinates(g)
+	if err != nil {
+		return nil, err
+	}
+	switch c := coords.(type) {
+	case []float64:
+		return validatePositions(toPoints([][]float64{c}), -1, -1), nil
+	case [][]float64:
+		if g.GeoJSONType == geojson.MultiPoint {
+			return validatePositions(toPoints(c), -1, -1), nil
+		}
+		return validateLine(toPoints(c), -1), nil
+	case [][][]float64:
+		if g.GeoJSONType == geojson.Polygon {
+			return validatePolygon(toRings(c), -1), nil
+		}
+		for i, l := range c {
+			errs = append(errs, validateLine(toPoints(l), i)...)
+		}
+		return errs, nil
+	case [][][][]float64:
+		for i, p := range c {
+			errs = append(errs, validatePolygon(toRings(p), i)...)
+		}
+	}
+	return errs, nil
+}
+
+// collectionGeometries returns the geometries of a Geometry of the GeometryCollection type, which are held in its
+// coordinates when it is built in Go. A GeometryCollection decoded from JSON has no coordinates.
+func collectionGeometries(g *geometry.Geometry) ([]geometry.Geometry, error) {
+	switch c := g.Coordinates.(type) {
+	case nil:
+		return nil, nil
+	case []geometry.Geometry:
+		return c, nil
+	case geometry.Collection:
+		return c.Geometries, nil
+	case *geometry.Collection:
+		return c.Geometries, nil
+	}
+	return nil, errors.New("invalid geometry collection")
+}
+
+func toPoints(coords [][]float64) []geometry.Point {
+	pts := []geometry.Point{}
+	for _, c := range coords {
+		p := geometry.Point{Lng: math.NaN(), Lat: math.NaN()}
+		if len(c) >= 2 {
+			p = geometry.Point{Lng: c[0], Lat: c[1]}
+		}
+		pts = append(pts, p)
+	}
+	return pts
+}
+
+func toRings(coords [][][]float64) [][]geometry.Point {
+	rings := [][]geometry.Point{}
+	for _, r := range coords {
+		rings = append(rings, toPoints(r))
+	}
+	return rings
+}
+
+func newError(reason Reason, polygon int, ring int, position int, p *geometry.Point) ValidationError {
+	return ValidationError{
+		Reason:   reason,
+		Feature:  -1,
+		Geometry: -1,
+		Polygon:  polygon,
+		Ring:     ring,
+		Position: position,
+		Point:    p,
+	}
+}
+
+func validatePositions(pts []geometry.Point, polygon int, ring int) []ValidationError {
+	errs := []ValidationError{}
+	for i, p := range pts {
+		p := p
+		if math.IsNaN(p.Lng) || math.IsNaN(p.Lat) || math.IsInf(p.Lng, 0) || math.IsInf(p.Lat, 0) {
+			errs = append(errs, newError(NonFiniteCoordinate, polygon, ring, i, nil))
+		} else if p.Lng < -180 || p.Lng > 180 || p.Lat < -90 || p.Lat > 90 {
+			errs = append(errs, newError(OutOfRange, polygon, ring, i, &p))
+		}
+	}
+	return errs
+}
+
+func validateLine(pts []geometry.Point, line int) []ValidationError {
+	errs := validatePositions(pts, -1, line)
+	if len(pts) < 2 {
+		errs = append(errs, newError(TooFewPositions, -1, line, -1, nil))
+	}
+	return errs
+}
+
+func validatePolygon(rings [][]geometry.Point, polygon int) []ValidationError {
+	errs := []ValidationError{}
+	for i, r := range rings {
+		errs = append(errs, validatePositions(r, polygon, i)...)
+	}
+	if len(errs) > 0 {
+		// the topology of non-finite coordinates can't be checked
+		return errs
+	}
+
+	for i, r := range rings {
+		if len(r) < 4 {
+			errs = append(errs, newError(TooFewPositions, polygon, i, -1, nil))
+		}
+		if len(r) > 0 && r[0] != r[len(r)-1] {
+			errs = append(errs, newError(UnclosedRing, polygon, i, len(r)-1, nil))
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	for i, r := range rings {
+		for _, p := range ringKinks(r) {
+			p := p
+			errs = append(errs, newError(SelfIntersection, polygon, i, -1, &p))
+		}
+	}
+
+	for i := 1; i < len(rings); i++ {
+		if crossing, p := ringsCross(rings[0], rings[i]); crossing {
+			errs = append(errs, newError(SelfIntersection, polygon, i, -1, p))
+		}
+		if p, outside := outsideRing(rings[i], rings[0]); outside {
+			errs = append(errs, newError(HoleOutsideShell, polygon, i, -1, p))
+		}
+		for j := 1; j < i; j++ {
+			if crossing, p := ringsCross(rings[j], rings[i]); crossing {
+				errs = append(errs, newError(OverlappingHoles, polygon, i, -1, p))
+			} else if p, inside := insideRing(rings[i], rings[j]); inside {
+				errs = append(errs, newError(OverlappingHoles, polygon, i, -1, p))
+			} else if p, inside := insideRing(rings[j], rings[i]); inside {
+				errs = append(errs, newError(OverlappingHoles, polygon, i, -1, p))
+			}
+		}
+	}
+	return errs
+}
+
+// ringKinks returns the points where non adjacent segments of a closed ring meet.
+func ringKinks(r []geometry.Point) []geometry.Point {
+	ring := []geometry.Point{}
+	for i, p := range r {
+		if i == 0 || p != r[i-1] {
+			ring = append(ring, p)
+		}
+	}
+
+	pts := []geometry.Point{}
+	n := len(ring) - 1
+	for k := 0; k < n; k++ {
+		for m := k + 2; m < n; m++ {
+			if k == 0 && m == n-1 {
+				continue
+			}
+			for _, p := range planar.Intersection(planar.Segment{A: ring[k], B: ring[k+1]}, planar.Segment{A: ring[m], B: ring[m+1]}) {
+				if !containsPoint(pts, p) {
+					pts = append(pts, p)
+				}
+			}
+		}
+	}
+	return pts
+}
+
+// ringsCross reports whether two rings meet in more than one point, which means that they either cross
+// or share a part of their boundary. Rings are allowed to touch at a single point.
+func ringsCross(r1 []geometry.Point, r2 []geometry.Point) (bool, *geometry.Point) {
+	pts := []geometry.Point{}
+	for _, s1 := range planar.Segments(r1) {
+		for _, s2 := range planar.Segments(r2) {
+			for _, p := range planar.Intersection(s1, s2) {
+				if !containsPoint(pts, p) {
+					pts = append(pts, p)
+				}
+			}
+		}
+	}
+	if len(pts) > 1 {
+		return true, &pts[0]
+	}
+	return false, nil
+}
+
+// outsideRing returns the first position of r that lies outside the ring, ignoring positions on its boundary.
+func outsideRing(r []geometry.Point, ring []geometry.Point) (*geometry.Point, bool) {
+	for _, p := range r {
+		p := p
+		if !onRing(p, ring) && !planar.InRing(p, ring) {
+			return &p, true
+		}
+	}
+	return nil, false
+}
+
+// insideRing returns the first position of r that lies inside the ring, ignoring positions on its boundary.
+func insideRing(r []geometry.Point, ring []geometry.Point) (*geometry.Point, bool) {
+	for _, p := range r {
+		p := p
+		if !onRing(p, ring) && planar.InRing(p, ring) {
+			return &p, true
+		}
+	}
+	return nil, false
+}
+
+func onRing(p geometry.Point, ring []geometry.Point) bool {
+	for _, s := range planar.Segments(ring) {
+		if planar.Distance(p, s) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func containsPoint(pts []geometry.Point, p geometry.Point) bool {
+	for _, v := range pts {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,181 @@
+package booleans
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+)
+
+func TestValid(t *testing.T) {
+	tests := map[string]struct {
+		geojson string
+		want    bool
+	}{
+		"point": {
+			geojson: "{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Point\", \"coordinates\": [102, 0.5] } }",
+			want:    true,
+		},
+		"linestring": {
+			geojson: "{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [1, 1]] } }",
+			want:    true,
+		},
+		"polygon with hole": {
+			geojson: "{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]], [[2, 2], [2, 4], [4, 4], [4, 2], [2, 2]]] } }",
+			want:    true,
+		},
+		"hole touching the shell at a point": {
+			geojson: "{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]], [[0, 5], [4, 4], [4, 6], [0, 5]]] } }",
+			want:    true,
+		},
+		"bowtie": {
+			geojson: "{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [2, 2], [2, 0], [0, 2], [0, 0]]] } }",
+			want:    false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := feature.FromJSON(tt.geojson)
+			assert.Nil(t, err)
+			got, err := Valid(f)
+			assert.Nil(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestValidateReasons(t *testing.T) {
+	tests := map[string]struct {
+		geojson string
+		want    []Reason
+	}{
+		"unclosed ring": {
+			geojson: "{ \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [10, 10], [0, 10]]] }",
+			want:    []Reason{UnclosedRing},
+		},
+		"too few positions": {
+			geojson: "{ \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [0, 0]]] }",
+			want:    []Reason{TooFewPositions},
+		},
+		"hole outside shell": {
+			geojson: "{ \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]], [[20, 20], [20, 24], [24, 24], [24, 20], [20, 20]]] }",
+			want:    []Reason{HoleOutsideShell},
+		},
+		"hole crossing shell": {
+			geojson: "{ \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]], [[8, 2], [8, 4], [12, 4], [12, 2], [8, 2]]] }",
+			want:    []Reason{SelfIntersection, HoleOutsideShell},
+		},
+		"overlapping holes": {
+			geojson: "{ \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]], [[2, 2], [2, 6], [6, 6], [6, 2], [2, 2]], [[3, 3], [3, 4], [4, 4], [4, 3], [3, 3]]] }",
+			want:    []Reason{OverlappingHoles},
+		},
+		"out of range": {
+			geojson: "{ \"type\": \"Point\", \"coordinates\": [190, 0] }",
+			want:    []Reason{OutOfRange},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := geometry.FromJSON(tt.geojson)
+			assert.Nil(t, err)
+			errs, err := Validate(g)
+			assert.Nil(t, err)
+			got := []Reason{}
+			for _, e := range errs {
+				got = append(got, e.Reason)
+			}
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestValidateFeatureCollection(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"MultiPolygon\", \"coordinates\": [[[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]]], [[[0, 0], [2, 2], [2, 0], [0, 2], [0, 0]]]] } }" +
+		"] }")
+	assert.Nil(t, err)
+
+	errs, err := Validate(fc)
+	assert.Nil(t, err)
+	assert.Equal(t, len(errs), 1)
+	assert.Equal(t, errs[0].Reason, SelfIntersection)
+	assert.Equal(t, errs[0].Feature, 1)
+	assert.Equal(t, errs[0].Polygon, 1)
+	assert.Equal(t, errs[0].Ring, 0)
+	assert.Equal(t, *errs[0].Point, geometry.Point{Lng: 1, Lat: 1})
+	assert.Equal(t, errs[0].Error(), "feature 1, polygon 1, ring 0: self-intersection at [1, 1]")
+}
+
+func TestValidateNonFinite(t *testing.T) {
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: math.NaN(), Lat: 1}}}
+	errs, err := Validate(&ln)
+	assert.Nil(t, err)
+	assert.Equal(t, len(errs), 1)
+	assert.Equal(t, errs[0].Reason, NonFiniteCoordinate)
+	assert.Equal(t, errs[0].Position, 1)
+}
+
+func TestValidateNonFiniteFeature(t *testing.T) {
+	tests := map[string]struct {
+		geom     geometry.Geometry
+		position int
+	}{
+		"point": {
+			geom:     geometry.Geometry{GeoJSONType: geojson.Point, Coordinates: []float64{math.Inf(1), 0}},
+			position: 0,
+		},
+		"polygon": {
+			geom:     geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: [][][]float64{{{0, 0}, {1, 0}, {1, math.NaN()}, {0, 0}}}},
+			position: 2,
+		},
+		"decoded multipolygon": {
+			geom:     geometry.Geometry{GeoJSONType: geojson.MultiPolygon, Coordinates: []interface{}{[]interface{}{[]interface{}{[]interface{}{0.0, 0.0}, []interface{}{math.NaN(), 0.0}, []interface{}{1.0, 1.0}, []interface{}{0.0, 0.0}}}}},
+			position: 1,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := feature.New(tt.geom, nil, nil, "")
+			assert.Nil(t, err)
+			errs, err := Validate(f)
+			assert.Nil(t, err)
+			assert.Equal(t, len(errs), 1)
+			assert.Equal(t, errs[0].Reason, NonFiniteCoordinate)
+			assert.Equal(t, errs[0].Position, tt.position)
+		})
+	}
+}
+
+func TestValidateFeatureGeometryCollection(t *testing.T) {
+	unclosed := geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: [][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}}}
+	point := geometry.Geometry{GeoJSONType: geojson.Point, Coordinates: []float64{0, 0}}
+	fc := &feature.Collection{Features: []feature.Feature{
+		{Geometry: point},
+		{Geometry: geometry.Geometry{GeoJSONType: geojson.GeometryCollection, Coordinates: []geometry.Geometry{point, unclosed}}},
+		{Geometry: geometry.Geometry{GeoJSONType: geojson.GeometryCollection, Coordinates: &geometry.Collection{Geometries: []geometry.Geometry{point}}}},
+	}}
+	errs, err := Validate(fc)
+	assert.Nil(t, err)
+	assert.Equal(t, len(errs), 1)
+	assert.Equal(t, errs[0].Reason, UnclosedRing)
+	assert.Equal(t, errs[0].Feature, 1)
+	assert.Equal(t, errs[0].Geometry, 1)
+
+	// the geometries of a GeometryCollection decoded from JSON are dropped by the geojson package
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"GeometryCollection\", \"geometries\": [{ \"type\": \"Point\", \"coordinates\": [0, 0] }] } }")
+	assert.Nil(t, err)
+	valid, err := Valid(f)
+	assert.Nil(t, err)
+	assert.True(t, valid)
+}
+
+func TestValidateUnsupported(t *testing.T) {
+	_, err := Valid("invalid")
+	assert.Equal(t, err.Error(), "unsupported geojson type")
+}
@@ -24,6 +24,9 @@ type Parameters struct {
 	k            int              // number of clusters
 	points       []geometry.Point // pointSet
 	distanceType Distance
+	// Rand is the source of the random initial centroids, so that the same seed always returns the same clusters.
+	// A source seeded with the current time is the default value
+	Rand *rand.Rand
 }
 
 // KMeans initialisation
@@ -169,7 +172,11 @@ func getCentroids(params Parameters) (map[int]bool, []geometry.Point) {
 	var centroids []geometry.Point
 	ctrIdx := make(map[int]bool)
 
-	idx := getRandoms(len(params.points), params.k)
+	r := params.Rand
+	if r == nil {
+		r = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	idx := getRandoms(r, len(params.points), params.k)
 	for _, v := range idx {
 		centroids = append(centroids, params.points[v])
 		ctrIdx[v] = true
@@ -179,8 +186,7 @@ func getCentroids(params Parameters) (map[int]bool, []geometry.Point) {
 }
 
 // l length, k number of clusters
-func getRandoms(l int, k int) []int {
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+func getRandoms(r *rand.Rand, l int, k int) []int {
 	return r.Perm(l)[:k]
 }
 
@@ -2,6 +2,7 @@ package clustering
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/tomchavakis/geojson/geometry"
@@ -90,3 +91,13 @@ func TestKMeans(t *testing.T) {
 	assert.Equal(t, len(res), 2)
 	assert.Equal(t, res, clusters)
 }
+
+func TestKMeansSeed(t *testing.T) {
+	points := []geometry.Point{{Lat: 20.0, Lng: 10.0}, {Lat: 25.0, Lng: 10.0}, {Lat: 60.0, Lng: 10.0}, {Lat: 18.0, Lng: 11.0}, {Lat: 18.0, Lng: 10.0}, {Lat: 50.0, Lng: 11.0}}
+	centroids := func(seed int64) []geometry.Point {
+		_, c := getCentroids(Parameters{k: 3, points: points, distanceType: Euclidean, Rand: rand.New(rand.NewSource(seed))})
+		return c
+	}
+	assert.Equal(t, centroids(42), centroids(42))
+	assert.Equal(t, len(centroids(42)), 3)
+}
@@ -0,0 +1,215 @@
+package conversions
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+	meta "github.com/tomchavakis/turf-go/meta/coordAll"
+)
+
+// Explode returns every position of the GeoJSON object as a Point feature, including the closing positions of the rings.
+// The points keep the properties of the feature they belong to.
+// t can be a FeatureCollection, Feature, GeometryCollection, Geometry or any geometry type.
+//
+// Examples:
+//
+//	fc, err := conversions.Explode(poly)
+func Explode(t interface{}) (*feature.Collection, error) {
+	excludeWrapCoord := false
+	features := []feature.Feature{}
+	explode := func(t interface{}, properties map[string]interface{}) error {
+		coords, err := meta.CoordAll(t, &excludeWrapCoord)
+		if err != nil {
+			return err
+		}
+		for _, p := range coords {
+			f, err := feature.New(geometry.Geometry{GeoJSONType: geojson.Point, Coordinates: common.PointCoords(p)}, nil, properties, "")
+			if err != nil {
+				return err
+			}
+			features = append(features, *f)
+		}
+		return nil
+	}
+
+	var err error
+	switch gtp := t.(type) {
+	case *feature.Collection:
+		for i := range gtp.Features {
+			if err = explode(&gtp.Features[i], gtp.Features[i].Properties); err != nil {
+				break
+			}
+		}
+	case *feature.Feature:
+		err = explode(gtp, gtp.Properties)
+	case *geometry.Geometry:
+		err = explode(&geometry.Collection{Geometries: []geometry.Geometry{*gtp}}, nil)
+	case *geometry.Collection, *geometry.Point, *geometry.MultiPoint, *geometry.LineString,
+		*geometry.MultiLineString, *geometry.Polygon, *geometry.MultiPolygon:
+		err = explode(gtp, nil)
+	default:
+		return nil, errors.New("unknown geometry type")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// Flatten splits the MultiPoints, MultiLineStrings and MultiPolygons of the GeoJSON object into features of their
+// single geometries, which keep the properties of the feature they belong to. The other geometries are returned as they are.
+// t can be a FeatureCollection, Feature, Geometry or any geometry type.
+//
+// Examples:
+//
+//	fc, err := conversions.Flatten(multiPolygon)
+//	poly, err := fc.Features[0].ToPolygon()
+func Flatten(t interface{}) (*feature.Collection, error) {
+	input := []feature.Feature{}
+	switch gtp := t.(type) {
+	case *feature.Collection:
+		input = gtp.Features
+	case *feature.Feature:
+		input = append(input, *gtp)
+	default:
+		g, err := common.ToGeometry(t)
+		if err != nil {
+			return nil, err
+		}
+		input = append(input, feature.Feature{Type: geojson.Feature, Geometry: *g})
+	}
+
+	features := []feature.Feature{}
+	for _, f := range input {
+		parts, err := flattenGeometry(f.Geometry)
+		if err != nil {
+			return nil, err
+		}
+		if parts == nil {
+			features = append(features, f)
+			continue
+		}
+		for _, g := range parts {
+			nf, err := feature.New(g, nil, f.Properties, "")
+			if err != nil {
+				return nil, err
+			}
+			features = append(features, *nf)
+		}
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// flattenGeometry returns the single geometries of a Multi geometry, or nil for any other geometry.
+func flattenGeometry(g geometry.Geometry) ([]geometry.Geometry, error) {
+	parts := []geometry.Geometry{}
+	switch g.GeoJSONType {
+	case geojson.MultiPoint:
+		mp, err := g.ToMultiPoint()
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range mp.Coordinates {
+			parts = append(parts, geometry.Geometry{GeoJSONType: geojson.Point, Coordinates: common.PointCoords(p)})
+		}
+	case geojson.MultiLineString:
+		ml, err := g.ToMultiLineString()
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range ml.Coordinates {
+			parts = append(parts, geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: common.LineStringCoords(l.Coordinates)})
+		}
+	case geojson.MultiPolygon:
+		mp, err := g.ToMultiPolygon()
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range mp.Coordinates {
+			parts = append(parts, geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: common.PolygonCoords(p)})
+		}
+	default:
+		return nil, nil
+	}
+	return parts, nil
+}
+
+// Combine merges the features of the FeatureCollection into a MultiPoint, a MultiLineString and a MultiPolygon feature,
+// one for each kind of geometry found. The properties of the merged features are collected in the order of the features
+// under the "collectedProperties" property.
+//
+// Examples:
+//
+//	fc, err := conversions.Combine(points)
+//	mp, err := fc.Features[0].ToMultiPoint()
+func Combine(fc *feature.Collection) (*feature.Collection, error) {
+	points := []geometry.Point{}
+	lines := []geometry.LineString{}
+	polys := []geometry.Polygon{}
+	pointProps, lineProps, polyProps := []interface{}{}, []interface{}{}, []interface{}{}
+
+	for i := range fc.Features {
+		f := &fc.Features[i]
+		switch f.Geometry.GeoJSONType {
+		case geojson.Point:
+			p, err := f.Geometry.ToPoint()
+			if err != nil {
+				return nil, err
+			}
+			points = append(points, *p)
+			pointProps = append(pointProps, f.Properties)
+		case geojson.MultiPoint:
+			mp, err := f.Geometry.ToMultiPoint()
+			if err != nil {
+				return nil, err
+			}
+			points = append(points, mp.Coordinates...)
+			pointProps = append(pointProps, f.Properties)
+		case geojson.LineString, geojson.MultiLineString:
+			l, err := common.Lines(f)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, l...)
+			lineProps = append(lineProps, f.Properties)
+		case geojson.Polygon, geojson.MultiPolygon:
+			p, err := common.Polygons(f)
+			if err != nil {
+				return nil, err
+			}
+			polys = append(polys, p...)
+			polyProps = append(polyProps, f.Properties)
+		default:
+			return nil, errors.New("unsupported geometry type")
+		}
+	}
+
+	features := []feature.Feature{}
+	add := func(g geometry.Geometry, properties []interface{}) error {
+		f, err := feature.New(g, nil, map[string]interface{}{"collectedProperties": properties}, "")
+		if err != nil {
+			return err
+		}
+		features = append(features, *f)
+		return nil
+	}
+	if len(pointProps) > 0 {
+		if err := add(geometry.Geometry{GeoJSONType: geojson.MultiPoint, Coordinates: common.LineStringCoords(points)}, pointProps); err != nil {
+			return nil, err
+		}
+	}
+	if len(lineProps) > 0 {
+		if err := add(geometry.Geometry{GeoJSONType: geojson.MultiLineString, Coordinates: common.MultiLineStringCoords(lines)}, lineProps); err != nil {
+			return nil, err
+		}
+	}
+	if len(polyProps) > 0 {
+		if err := add(geometry.Geometry{GeoJSONType: geojson.MultiPolygon, Coordinates: common.MultiPolygonCoords(polys)}, polyProps); err != nil {
+			return nil, err
+		}
+	}
+	return feature.NewFeatureCollection(features)
+}
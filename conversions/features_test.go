@@ -0,0 +1,94 @@
+package conversions
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+)
+
+func TestExplode(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"a\" }, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [1, 0], [1, 1], [0, 0]]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"b\" }, \"geometry\": { \"type\": \"MultiPoint\", \"coordinates\": [[5, 5], [6, 6]] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	res, err := Explode(fc)
+	if err != nil {
+		t.Errorf("Explode error %v", err)
+	}
+	assert.Equal(t, len(res.Features), 6)
+	assert.Equal(t, res.Features[3].Geometry.Coordinates, []float64{0, 0})
+	assert.Equal(t, res.Features[3].Properties["name"], "a")
+	assert.Equal(t, res.Features[5].Geometry.GeoJSONType, geojson.Point)
+	assert.Equal(t, res.Features[5].Geometry.Coordinates, []float64{6, 6})
+	assert.Equal(t, res.Features[5].Properties["name"], "b")
+
+	g := &geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: [][]float64{{1, 2}, {3, 4}}}
+	res, err = Explode(g)
+	if err != nil {
+		t.Errorf("Explode error %v", err)
+	}
+	assert.Equal(t, len(res.Features), 2)
+	assert.Equal(t, res.Features[1].Geometry.Coordinates, []float64{3, 4})
+}
+
+func TestFlatten(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"a\" }, \"geometry\": { \"type\": \"MultiPolygon\", \"coordinates\": [[[[0, 0], [1, 0], [1, 1], [0, 0]]], [[[5, 5], [6, 5], [6, 6], [5, 5]]]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"b\" }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[5, 5], [6, 6]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"c\" }, \"geometry\": { \"type\": \"MultiLineString\", \"coordinates\": [[[0, 0], [1, 1]], [[2, 2], [3, 3]]] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	res, err := Flatten(fc)
+	if err != nil {
+		t.Errorf("Flatten error %v", err)
+	}
+	types := []geojson.OBjectType{geojson.Polygon, geojson.Polygon, geojson.LineString, geojson.LineString, geojson.LineString}
+	names := []string{"a", "a", "b", "c", "c"}
+	assert.Equal(t, len(res.Features), len(types))
+	for i, f := range res.Features {
+		assert.Equal(t, f.Geometry.GeoJSONType, types[i])
+		assert.Equal(t, f.Properties["name"], names[i])
+	}
+	assert.Equal(t, res.Features[1].Geometry.Coordinates, [][][]float64{{{5, 5}, {6, 5}, {6, 6}, {5, 5}}})
+	assert.Equal(t, res.Features[4].Geometry.Coordinates, [][]float64{{2, 2}, {3, 3}})
+
+	mp := &geometry.MultiPoint{Coordinates: []geometry.Point{{Lng: 1, Lat: 2}, {Lng: 3, Lat: 4}}}
+	res, err = Flatten(mp)
+	if err != nil {
+		t.Errorf("Flatten error %v", err)
+	}
+	assert.Equal(t, len(res.Features), 2)
+	assert.Equal(t, res.Features[0].Geometry.Coordinates, []float64{1, 2})
+}
+
+func TestCombine(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"a\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0, 0] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"b\" }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[5, 5], [6, 6]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"c\" }, \"geometry\": { \"type\": \"MultiPoint\", \"coordinates\": [[1, 1], [2, 2]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"d\" }, \"geometry\": { \"type\": \"MultiLineString\", \"coordinates\": [[[0, 0], [1, 1]], [[2, 2], [3, 3]]] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	res, err := Combine(fc)
+	if err != nil {
+		t.Errorf("Combine error %v", err)
+	}
+	assert.Equal(t, len(res.Features), 2)
+	assert.Equal(t, res.Features[0].Geometry.GeoJSONType, geojson.MultiPoint)
+	assert.Equal(t, res.Features[0].Geometry.Coordinates, [][]float64{{0, 0}, {1, 1}, {2, 2}})
+	props := res.Features[0].Properties["collectedProperties"].([]interface{})
+	assert.Equal(t, len(props), 2)
+	assert.Equal(t, props[1].(map[string]interface{})["name"], "c")
+	assert.Equal(t, res.Features[1].Geometry.GeoJSONType, geojson.MultiLineString)
+	assert.Equal(t, res.Features[1].Geometry.Coordinates, [][][]float64{{{5, 5}, {6, 6}}, {{0, 0}, {1, 1}}, {{2, 2}, {3, 3}}})
+}
@@ -0,0 +1,178 @@
+package conversions
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// LineToPolygonOptions ...
+type LineToPolygonOptions struct {
+	// AutoComplete closes the lines whose last position differs from the first one. true is the default value
+	AutoComplete *bool
+	// OrderCoords sorts the rings by the area of their bbox, so that the largest one becomes the outer ring. true is the default value
+	OrderCoords *bool
+	// Orient rewinds the outer ring counter-clockwise and the holes clockwise, following the right-hand rule. false is the default value
+	Orient bool
+}
+
+// PolygonToLine converts the rings of a Polygon or MultiPolygon to lines.
+// Every polygon becomes a LineString feature if it has no holes, otherwise a MultiLineString feature
+// with the outer ring first. t can be a Feature, Geometry, Polygon or MultiPolygon and the features
+// keep the properties of a Feature.
+//
+// Examples:
+//
+//	fc, err := conversions.PolygonToLine(poly)
+//	ln, err := fc.Features[0].ToLineString()
+func PolygonToLine(t interface{}) (*feature.Collection, error) {
+	polys, err := common.Polygons(t)
+	if err != nil {
+		return nil, err
+	}
+
+	var properties map[string]interface{}
+	if f, ok := t.(*feature.Feature); ok {
+		properties = f.Properties
+	}
+
+	features := []feature.Feature{}
+	for _, p := range polys {
+		geom := geometry.Geometry{GeoJSONType: geojson.MultiLineString, Coordinates: common.MultiLineStringCoords(p.Coordinates)}
+		if len(p.Coordinates) == 1 {
+			geom = geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: common.LineStringCoords(p.Coordinates[0].Coordinates)}
+		}
+		f, err := feature.New(geom, nil, properties, "")
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// LineToPolygon converts a LineString to a Polygon, or the lines of a MultiLineString to the rings of a Polygon.
+// t can be a Feature, Geometry, LineString or MultiLineString and the Polygon keeps the properties of a Feature.
+//
+// Examples:
+//
+//	f, err := conversions.LineToPolygon(ln, LineToPolygonOptions{AutoComplete: common.BoolPtr(false)})
+//	poly, err := f.ToPolygon()
+func LineToPolygon(t interface{}, options LineToPolygonOptions) (*feature.Feature, error) {
+	if options.AutoComplete == nil {
+		options.AutoComplete = common.BoolPtr(true)
+	}
+	if options.OrderCoords == nil {
+		options.OrderCoords = common.BoolPtr(true)
+	}
+
+	lines, err := common.Lines(t)
+	if err != nil {
+		return nil, err
+	}
+
+	rings := []geometry.LineString{}
+	for _, l := range lines {
+		pts := append([]geometry.Point{}, l.Coordinates...)
+		if *options.AutoComplete && len(pts) > 0 && pts[0] != pts[len(pts)-1] {
+			pts = append(pts, pts[0])
+		}
+		if len(pts) < 4 {
+			return nil, errors.New("a ring must have at least four positions")
+		}
+		if pts[0] != pts[len(pts)-1] {
+			return nil, errors.New("a ring must be closed")
+		}
+		rings = append(rings, geometry.LineString{Coordinates: pts})
+	}
+
+	if *options.OrderCoords {
+		sort.SliceStable(rings, func(i, j int) bool {
+			return bboxArea(rings[i].Coordinates) > bboxArea(rings[j].Coordinates)
+		})
+	}
+	if options.Orient {
+		for i, r := range rings {
+			if (i == 0) != (planar.SignedArea(r.Coordinates) > 0) {
+				rings[i].Coordinates = reverse(r.Coordinates)
+			}
+		}
+	}
+
+	var properties map[string]interface{}
+	if f, ok := t.(*feature.Feature); ok {
+		properties = f.Properties
+	}
+	geom := geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: common.PolygonCoords(geometry.Polygon{Coordinates: rings})}
+	return feature.New(geom, nil, properties, "")
+}
+
+// Polygonize returns the Polygons enclosed by a set of lines. The lines are split where they cross,
+// lines that don't enclose any area are ignored and faces enclosed by other faces are returned both as
+// Polygons and as holes of the surrounding Polygon. Outer rings are oriented counter-clockwise and holes clockwise.
+// t can be a FeatureCollection of lines, Feature, Geometry, LineString or MultiLineString.
+//
+// Examples:
+//
+//	fc, err := conversions.Polygonize(lines)
+func Polygonize(t interface{}) (*feature.Collection, error) {
+	lines := []geometry.LineString{}
+	if fc, ok := t.(*feature.Collection); ok {
+		for i := range fc.Features {
+			l, err := common.Lines(&fc.Features[i])
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, l...)
+		}
+	} else {
+		l, err := common.Lines(t)
+		if err != nil {
+			return nil, err
+		}
+		lines = l
+	}
+
+	segs := []planar.Segment{}
+	for _, l := range lines {
+		segs = append(segs, planar.Segments(l.Coordinates)...)
+	}
+
+	features := []feature.Feature{}
+	for _, p := range planar.Node(segs).Faces() {
+		geom := geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: common.PolygonCoords(p)}
+		f, err := feature.New(geom, nil, nil, "")
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// bboxArea returns the planar area of the bbox of the positions.
+func bboxArea(pts []geometry.Point) float64 {
+	west, south := math.Inf(1), math.Inf(1)
+	east, north := math.Inf(-1), math.Inf(-1)
+	for _, p := range pts {
+		west = math.Min(west, p.Lng)
+		east = math.Max(east, p.Lng)
+		south = math.Min(south, p.Lat)
+		north = math.Max(north, p.Lat)
+	}
+	return (east - west) * (north - south)
+}
+
+func reverse(pts []geometry.Point) []geometry.Point {
+	res := make([]geometry.Point, len(pts))
+	for i, p := range pts {
+		res[len(pts)-1-i] = p
+	}
+	return res
+}
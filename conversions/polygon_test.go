@@ -0,0 +1,133 @@
+package conversions
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+func TestPolygonToLine(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": { \"name\": \"a\" }, \"geometry\": { \"type\": \"MultiPolygon\", \"coordinates\": [[[[0, 0], [4, 0], [4, 4], [0, 0]]], [[[10, 10], [14, 10], [14, 14], [10, 14], [10, 10]], [[11, 11], [11, 12], [12, 12], [11, 11]]]] } }")
+	if err != nil {
+		t.Errorf("FromJSON error %v", err)
+	}
+	fc, err := PolygonToLine(f)
+	if err != nil {
+		t.Errorf("PolygonToLine error %v", err)
+	}
+	assert.Equal(t, len(fc.Features), 2)
+	assert.Equal(t, fc.Features[0].Properties["name"], "a")
+	assert.Equal(t, fc.Features[0].Geometry.GeoJSONType, geojson.LineString)
+	assert.Equal(t, fc.Features[0].Geometry.Coordinates, [][]float64{{0, 0}, {4, 0}, {4, 4}, {0, 0}})
+	assert.Equal(t, fc.Features[1].Geometry.GeoJSONType, geojson.MultiLineString)
+	assert.Equal(t, fc.Features[1].Geometry.Coordinates, [][][]float64{
+		{{10, 10}, {14, 10}, {14, 14}, {10, 14}, {10, 10}},
+		{{11, 11}, {11, 12}, {12, 12}, {11, 11}},
+	})
+}
+
+func TestLineToPolygon(t *testing.T) {
+	hole := geometry.LineString{Coordinates: []geometry.Point{{Lng: 1, Lat: 1}, {Lng: 2, Lat: 1}, {Lng: 2, Lat: 2}, {Lng: 1, Lat: 1}}}
+	outer := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0, Lat: 4}, {Lng: 4, Lat: 4}, {Lng: 4, Lat: 0}}}
+	ml := &geometry.MultiLineString{Coordinates: []geometry.LineString{hole, outer}}
+
+	tests := map[string]struct {
+		options LineToPolygonOptions
+		want    [][][]float64
+		err     string
+	}{
+		"default": {
+			options: LineToPolygonOptions{},
+			want: [][][]float64{
+				{{0, 0}, {0, 4}, {4, 4}, {4, 0}, {0, 0}},
+				{{1, 1}, {2, 1}, {2, 2}, {1, 1}},
+			},
+		},
+		"orient": {
+			options: LineToPolygonOptions{Orient: true},
+			want: [][][]float64{
+				{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+				{{1, 1}, {2, 2}, {2, 1}, {1, 1}},
+			},
+		},
+		"keep order": {
+			options: LineToPolygonOptions{OrderCoords: common.BoolPtr(false)},
+			want: [][][]float64{
+				{{1, 1}, {2, 1}, {2, 2}, {1, 1}},
+				{{0, 0}, {0, 4}, {4, 4}, {4, 0}, {0, 0}},
+			},
+		},
+		"no auto complete": {
+			options: LineToPolygonOptions{AutoComplete: common.BoolPtr(false)},
+			err:     "a ring must be closed",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := LineToPolygon(ml, tt.options)
+			if tt.err != "" {
+				assert.Equal(t, err.Error(), tt.err)
+				return
+			}
+			if err != nil {
+				t.Errorf("LineToPolygon error %v", err)
+				return
+			}
+			assert.Equal(t, f.Geometry.GeoJSONType, geojson.Polygon)
+			assert.Equal(t, f.Geometry.Coordinates, tt.want)
+		})
+	}
+	// the input is left untouched
+	assert.Equal(t, len(outer.Coordinates), 4)
+}
+
+func TestPolygonize(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [2, 0], [2, 2], [0, 2], [0, 0]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[1, -1], [1, 3]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"MultiLineString\", \"coordinates\": [[[5, 5], [6, 5], [6, 6]], [[6, 6], [5, 5]]] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	res, err := Polygonize(fc)
+	if err != nil {
+		t.Errorf("Polygonize error %v", err)
+	}
+	assert.Equal(t, len(res.Features), 3)
+	areas := 0.0
+	for _, f := range res.Features {
+		p, err := f.ToPolygon()
+		if err != nil {
+			t.Errorf("ToPolygon error %v", err)
+		}
+		assert.Equal(t, len(p.Coordinates), 1)
+		a := planar.SignedArea(p.Coordinates[0].Coordinates)
+		assert.True(t, a > 0)
+		areas += a
+	}
+	assert.Equal(t, areas, 4.5)
+}
+
+func TestPolygonizeNested(t *testing.T) {
+	ml := &geometry.MultiLineString{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 4, Lat: 0}, {Lng: 4, Lat: 4}, {Lng: 0, Lat: 4}, {Lng: 0, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 1, Lat: 1}, {Lng: 2, Lat: 1}, {Lng: 2, Lat: 2}, {Lng: 1, Lat: 2}, {Lng: 1, Lat: 1}}},
+	}}
+	res, err := Polygonize(ml)
+	if err != nil {
+		t.Errorf("Polygonize error %v", err)
+	}
+	assert.Equal(t, len(res.Features), 2)
+	holes := 0
+	for _, f := range res.Features {
+		p, _ := f.ToPolygon()
+		holes += len(p.Coordinates) - 1
+	}
+	assert.Equal(t, holes, 1)
+}
@@ -0,0 +1,311 @@
+package graph
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/conversions"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// Options ...
+type Options struct {
+	// Units of the lengths and the tolerance. constants.UnitKilometers is the default value
+	Units string
+	// Tolerance is the distance below which the vertices of the lines are snapped to the same node. 0 is the default value
+	Tolerance float64
+	// WeightProperty is the numeric property of the lines that holds the cost of traversing a whole line, which is
+	// spread over its edges by their length. The edges cost their length if it is empty. "" is the default value
+	WeightProperty string
+	// SpeedProperty is the numeric property of the lines that holds their speed, in Units per hour. The edges cost
+	// the hours it takes to traverse them at that speed. "" is the default value
+	SpeedProperty string
+	// OneWayProperty is the property of the lines that marks them as one-way, when it is true or "yes".
+	// One-way lines can only be traversed in the direction of their positions. "" is the default value
+	OneWayProperty string
+}
+
+// Graph is a network of nodes, the vertices of a set of lines, linked by the segments of the lines.
+type Graph struct {
+	// Nodes are the positions of the nodes of the graph.
+	Nodes     []geometry.Point
+	adjacency [][]edge
+	index     map[[2]int64][]int
+	cellSize  float64
+	options   Options
+}
+
+// Route is a path through the graph.
+type Route struct {
+	// Path is the line through the nodes of the route.
+	Path geometry.LineString
+	// Cost is the sum of the costs of the edges of the route.
+	Cost float64
+}
+
+type edge struct {
+	to   int
+	cost float64
+}
+
+// New builds the graph of a FeatureCollection of LineString or MultiLineString features. Lines are connected where
+// they share a vertex, or have vertices closer than the tolerance, but not where they just cross each other.
+//
+// Examples:
+//
+//	g, err := graph.New(roads, graph.Options{Units: constants.UnitMeters, Tolerance: 1, OneWayProperty: "oneway"})
+//	route, err := g.Dijkstra(start, end)
+func New(lines *feature.Collection, options Options) (*Graph, error) {
+	if options.Units == "" {
+		options.Units = constants.UnitKilometers
+	}
+	if lines == nil {
+		return nil, errors.New("lines are required")
+	}
+	if options.Tolerance < 0 {
+		return nil, errors.New("tolerance must be a positive number")
+	}
+	if options.WeightProperty != "" && options.SpeedProperty != "" {
+		return nil, errors.New("lines can't have both a weight and a speed property")
+	}
+
+	g := &Graph{index: map[[2]int64][]int{}, options: options}
+	if options.Tolerance > 0 {
+		degrees, err := conversions.LengthToDegrees(options.Tolerance, options.Units)
+		if err != nil {
+			return nil, err
+		}
+		g.cellSize = degrees
+	}
+
+	for i := range lines.Features {
+		f := &lines.Features[i]
+		ls, err := common.Lines(f)
+		if err != nil {
+			return nil, err
+		}
+		oneWay := isTrue(f.Properties[options.OneWayProperty])
+
+		var weight *float64
+		total := 0.0
+		if options.WeightProperty != "" {
+			w, ok := common.ToFloat(f.Properties[options.WeightProperty])
+			if !ok {
+				return nil, errors.New("lines must have a numeric " + options.WeightProperty + " property")
+			}
+			weight = &w
+			for _, l := range ls {
+				length, err := measurement.Length(l, options.Units)
+				if err != nil {
+					return nil, err
+				}
+				total += length
+			}
+		}
+		var speed *float64
+		if options.SpeedProperty != "" {
+			v, ok := common.ToFloat(f.Properties[options.SpeedProperty])
+			if !ok || v <= 0 {
+				return nil, errors.New("lines must have a positive " + options.SpeedProperty + " property")
+			}
+			speed = &v
+		}
+
+		for _, l := range ls {
+			prev := -1
+			for _, p := range l.Coordinates {
+				n, err := g.node(p)
+				if err != nil {
+					return nil, err
+				}
+				if prev >= 0 && prev != n {
+					cost, err := measurement.PointDistance(g.Nodes[prev], g.Nodes[n], options.Units)
+					if err != nil {
+						return nil, err
+					}
+					if weight != nil {
+						cost = *weight * cost / total
+					}
+					if speed != nil {
+						cost /= *speed
+					}
+					g.adjacency[prev] = append(g.adjacency[prev], edge{to: n, cost: cost})
+					if !oneWay {
+						g.adjacency[n] = append(g.adjacency[n], edge{to: prev, cost: cost})
+					}
+				}
+				prev = n
+			}
+		}
+	}
+	return g, nil
+}
+
+// node returns the index of the node at the position, or within the tolerance from it, creating it if it doesn't exist.
+func (g *Graph) node(p geometry.Point) (int, error) {
+	key := g.cell(p)
+	if g.options.Tolerance > 0 {
+		// a degree of longitude is shorter than a degree of latitude away from the equator
+		span := int64(math.Ceil(1 / math.Max(math.Cos(p.Lat*math.Pi/180), 1e-6)))
+		best, bestDist := -1, g.options.Tolerance
+		for y := key[1] - 1; y <= key[1]+1; y++ {
+			for x := key[0] - span; x <= key[0]+span; x++ {
+				for _, n := range g.index[[2]int64{x, y}] {
+					d, err := measurement.PointDistance(p, g.Nodes[n], g.options.Units)
+					if err != nil {
+						return -1, err
+					}
+					if d <= bestDist {
+						best, bestDist = n, d
+					}
+				}
+			}
+		}
+		if best >= 0 {
+			return best, nil
+		}
+	} else if ids, ok := g.index[key]; ok {
+		return ids[0], nil
+	}
+
+	g.Nodes = append(g.Nodes, p)
+	g.adjacency = append(g.adjacency, []edge{})
+	g.index[key] = append(g.index[key], len(g.Nodes)-1)
+	return len(g.Nodes) - 1, nil
+}
+
+// cell returns the key of the index cell of the position. Without a tolerance every position has its own cell.
+func (g *Graph) cell(p geometry.Point) [2]int64 {
+	if g.cellSize == 0 {
+		return [2]int64{int64(math.Float64bits(p.Lng)), int64(math.Float64bits(p.Lat))}
+	}
+	return [2]int64{int64(math.Floor(p.Lng / g.cellSize)), int64(math.Floor(p.Lat / g.cellSize))}
+}
+
+// Nearest returns the index of the node closest to the position.
+func (g *Graph) Nearest(p geometry.Point) (int, error) {
+	if len(g.Nodes) == 0 {
+		return -1, errors.New("the graph has no nodes")
+	}
+	best, bestDist := -1, math.Inf(1)
+	for i, n := range g.Nodes {
+		d, err := measurement.PointDistance(p, n, g.options.Units)
+		if err != nil {
+			return -1, err
+		}
+		if d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+	return best, nil
+}
+
+// Dijkstra returns the cheapest route between the nodes closest to the start and the end positions.
+func (g *Graph) Dijkstra(start geometry.Point, end geometry.Point) (*Route, error) {
+	return g.route(start, end, false)
+}
+
+// AStar returns the cheapest route between the nodes closest to the start and the end positions, exploring first
+// the nodes closer to the end. The distance to the end only guides the search when the edges cost their length,
+// otherwise the search is the same as Dijkstra.
+func (g *Graph) AStar(start geometry.Point, end geometry.Point) (*Route, error) {
+	return g.route(start, end, g.options.WeightProperty == "" && g.options.SpeedProperty == "")
+}
+
+func (g *Graph) route(start geometry.Point, end geometry.Point, guided bool) (*Route, error) {
+	from, err := g.Nearest(start)
+	if err != nil {
+		return nil, err
+	}
+	to, err := g.Nearest(end)
+	if err != nil {
+		return nil, err
+	}
+
+	heuristic := func(n int) float64 { return 0 }
+	if guided {
+		heuristic = func(n int) float64 {
+			d, _ := measurement.PointDistance(g.Nodes[n], g.Nodes[to], g.options.Units)
+			return d
+		}
+	}
+
+	costs, prev := g.search(from, to, heuristic)
+	if math.IsInf(costs[to], 1) {
+		return nil, errors.New("no route between the positions")
+	}
+
+	path := []geometry.Point{}
+	for n := to; n >= 0; n = prev[n] {
+		path = append([]geometry.Point{g.Nodes[n]}, path...)
+	}
+	return &Route{Path: geometry.LineString{Coordinates: path}, Cost: costs[to]}, nil
+}
+
+// search runs a best first search from the node and returns the cost of reaching every node and the node it is
+// reached from. The search stops at the target node, unless it is negative.
+func (g *Graph) search(from int, target int, heuristic func(int) float64) ([]float64, []int) {
+	costs := make([]float64, len(g.Nodes))
+	prev := make([]int, len(g.Nodes))
+	for i := range costs {
+		costs[i] = math.Inf(1)
+		prev[i] = -1
+	}
+	costs[from] = 0
+
+	q := &queue{{node: from, priority: heuristic(from)}}
+	done := make([]bool, len(g.Nodes))
+	for q.Len() > 0 {
+		n := heap.Pop(q).(item).node
+		if done[n] {
+			continue
+		}
+		done[n] = true
+		if n == target {
+			break
+		}
+		for _, e := range g.adjacency[n] {
+			if c := costs[n] + e.cost; c < costs[e.to] {
+				costs[e.to] = c
+				prev[e.to] = n
+				heap.Push(q, item{node: e.to, priority: c + heuristic(e.to)})
+			}
+		}
+	}
+	return costs, prev
+}
+
+type item struct {
+	node     int
+	priority float64
+}
+
+// queue is a priority queue of nodes, with the lowest priority first.
+type queue []item
+
+func (q queue) Len() int            { return len(q) }
+func (q queue) Less(i, j int) bool  { return q[i].priority < q[j].priority }
+func (q queue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *queue) Push(x interface{}) { *q = append(*q, x.(item)) }
+func (q *queue) Pop() interface{} {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
+
+func isTrue(v interface{}) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case string:
+		return strings.EqualFold(b, "yes") || strings.EqualFold(b, "true")
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+func network(t *testing.T) *feature.Collection {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"cost\": 100, \"oneway\": \"yes\" }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [1, 0], [2, 0]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"cost\": 1 }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [0, 1], [2, 1]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"cost\": 1, \"oneway\": false }, \"geometry\": { \"type\": \"MultiLineString\", \"coordinates\": [[[2, 1.00001], [2, 0]], [[5, 5], [6, 6]]] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	return fc
+}
+
+func TestRoute(t *testing.T) {
+	short, _ := measurement.Distance(0, 0, 2, 0, constants.UnitKilometers)
+	// the cost of the last line is spread over both of its parts and the first part starts at the snapped node
+	edge, _ := measurement.Distance(2, 1, 2, 0, constants.UnitKilometers)
+	part, _ := measurement.Distance(2, 1.00001, 2, 0, constants.UnitKilometers)
+	isolated, _ := measurement.Distance(5, 5, 6, 6, constants.UnitKilometers)
+	tests := map[string]struct {
+		options Options
+		start   geometry.Point
+		end     geometry.Point
+		path    []geometry.Point
+		cost    float64
+	}{
+		"shortest": {
+			options: Options{Tolerance: 2},
+			start:   geometry.Point{Lng: 0.1, Lat: -0.1},
+			end:     geometry.Point{Lng: 2, Lat: 0},
+			path:    []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 2, Lat: 0}},
+			cost:    short,
+		},
+		"one-way": {
+			options: Options{Tolerance: 2, OneWayProperty: "oneway"},
+			start:   geometry.Point{Lng: 2, Lat: 0},
+			end:     geometry.Point{Lng: 0, Lat: 0},
+			path:    []geometry.Point{{Lng: 2, Lat: 0}, {Lng: 2, Lat: 1}, {Lng: 0, Lat: 1}, {Lng: 0, Lat: 0}},
+		},
+		"weight": {
+			options: Options{Tolerance: 2, WeightProperty: "cost"},
+			start:   geometry.Point{Lng: 0, Lat: 0},
+			end:     geometry.Point{Lng: 2, Lat: 0},
+			path:    []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0, Lat: 1}, {Lng: 2, Lat: 1}, {Lng: 2, Lat: 0}},
+			cost:    1 + edge/(part+isolated),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := New(network(t), tt.options)
+			if err != nil {
+				t.Errorf("New error %v", err)
+				return
+			}
+			for _, search := range []func(geometry.Point, geometry.Point) (*Route, error){g.Dijkstra, g.AStar} {
+				r, err := search(tt.start, tt.end)
+				if err != nil {
+					t.Errorf("route error %v", err)
+					return
+				}
+				assert.Equal(t, r.Path.Coordinates, tt.path)
+				if tt.cost > 0 {
+					assert.True(t, math.Abs(r.Cost-tt.cost) < 1e-9)
+				}
+			}
+		})
+	}
+}
+
+func TestNoRoute(t *testing.T) {
+	// without a tolerance the lines don't meet at [2, 1]
+	g, err := New(network(t), Options{})
+	if err != nil {
+		t.Errorf("New error %v", err)
+	}
+	assert.Equal(t, len(g.Nodes), 8)
+
+	_, err = g.Dijkstra(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 6, Lat: 6})
+	assert.Equal(t, err.Error(), "no route between the positions")
+}
+
+func TestGraphInvalid(t *testing.T) {
+	_, err := New(network(t), Options{WeightProperty: "speed"})
+	assert.Equal(t, err.Error(), "lines must have a numeric speed property")
+}
+
+func TestGraphIntProperties(t *testing.T) {
+	// properties set in Go rather than decoded from JSON can be integers
+	fc := network(t)
+	for i, cost := range []int{100, 1, 1} {
+		fc.Features[i].Properties["cost"] = cost
+		fc.Features[i].Properties["speed"] = int64(50)
+	}
+	g, err := New(fc, Options{Tolerance: 2, WeightProperty: "cost"})
+	if err != nil {
+		t.Errorf("New error %v", err)
+	}
+	r, err := g.Dijkstra(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 2, Lat: 0})
+	if err != nil {
+		t.Errorf("Dijkstra error %v", err)
+	}
+	assert.Equal(t, len(r.Path.Coordinates), 4)
+
+	g, err = New(fc, Options{Tolerance: 2, SpeedProperty: "speed"})
+	if err != nil {
+		t.Errorf("New error %v", err)
+	}
+	r, err = g.Dijkstra(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 2, Lat: 0})
+	if err != nil {
+		t.Errorf("Dijkstra error %v", err)
+	}
+	short, _ := measurement.Distance(0, 0, 2, 0, constants.UnitKilometers)
+	assert.True(t, math.Abs(r.Cost-short/50) < 1e-9)
+}
@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/internal/planar"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// IsochroneOptions ...
+type IsochroneOptions struct {
+	// MaxEdge is the longest distance, in the Units of the graph, between reachable nodes that are joined in the same
+	// area. The areas are the convex hulls of the reachable nodes without it. nil is the default value
+	MaxEdge *float64
+	// Property receives the threshold of every isochrone. "value" is the default value
+	Property *string
+}
+
+// Isochrones returns the areas reachable from the node closest to the origin within every threshold, which are
+// costs, the hours of travel with a SpeedProperty or the lengths of the lines by default, in ascending order.
+// Every threshold returns a Polygon feature, or a MultiPolygon feature if the area is not connected, with the
+// threshold in the Property. The area is the concave hull of the nodes reachable within the threshold: the union of
+// the triangles of their Delaunay triangulation whose sides are not longer than MaxEdge, along with the areas of the
+// lower thresholds so that the isochrones are nested.
+//
+// Examples:
+//
+//	g, err := graph.New(roads, graph.Options{SpeedProperty: "maxspeed"})
+//	fc, err := g.Isochrones(origin, []float64{0.25, 0.5, 1}, graph.IsochroneOptions{MaxEdge: common.Float64Ptr(2)})
+func (g *Graph) Isochrones(origin geometry.Point, thresholds []float64, options IsochroneOptions) (*feature.Collection, error) {
+	if options.Property == nil {
+		options.Property = common.StringPtr("value")
+	}
+	if options.MaxEdge != nil && *options.MaxEdge <= 0 {
+		return nil, errors.New("max edge must be a positive number")
+	}
+	for i, t := range thresholds {
+		if t < 0 || (i > 0 && t <= thresholds[i-1]) {
+			return nil, errors.New("thresholds must be positive and in ascending order")
+		}
+	}
+	from, err := g.Nearest(origin)
+	if err != nil {
+		return nil, err
+	}
+	costs, _ := g.search(from, -1, func(n int) float64 { return 0 })
+
+	features := []feature.Feature{}
+	area := []geometry.Polygon{}
+	for _, t := range thresholds {
+		pts := []geometry.Point{}
+		for n, c := range costs {
+			if c <= t {
+				pts = append(pts, g.Nodes[n])
+			}
+		}
+
+		pieces := append([]geometry.Polygon{}, area...)
+		for _, tr := range planar.Triangulate(pts) {
+			ring := []geometry.Point{pts[tr[0]], pts[tr[1]], pts[tr[2]], pts[tr[0]]}
+			short, err := g.shortSides(ring, options.MaxEdge)
+			if err != nil {
+				return nil, err
+			}
+			if short {
+				pieces = append(pieces, geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: ring}}})
+			}
+		}
+		if len(pieces) > 0 {
+			area = planar.Union(pieces)
+		}
+
+		geom := geometry.Geometry{GeoJSONType: geojson.MultiPolygon, Coordinates: common.MultiPolygonCoords(area)}
+		if len(area) == 1 {
+			geom = geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: common.PolygonCoords(area[0])}
+		}
+		f, err := feature.New(geom, nil, map[string]interface{}{*options.Property: t}, "")
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// shortSides reports whether none of the sides of the closed ring is longer than the max edge.
+func (g *Graph) shortSides(ring []geometry.Point, maxEdge *float64) (bool, error) {
+	if maxEdge == nil {
+		return true, nil
+	}
+	for i := 0; i < len(ring)-1; i++ {
+		d, err := measurement.PointDistance(ring[i], ring[i+1], g.options.Units)
+		if err != nil {
+			return false, err
+		}
+		if d > *maxEdge {
+			return false, nil
+		}
+	}
+	return true, nil
+}
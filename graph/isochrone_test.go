@@ -0,0 +1,101 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/internal/planar"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// streets returns a grid of 5 by 5 streets, 0.01 degrees apart, with a speed of 60 km/h.
+func streets(t *testing.T) *feature.Collection {
+	lines := []string{}
+	for i := 0; i < 5; i++ {
+		h, v := []string{}, []string{}
+		for j := 0; j < 5; j++ {
+			h = append(h, fmt.Sprintf("[%v, %v]", float64(j)/100, float64(i)/100))
+			v = append(v, fmt.Sprintf("[%v, %v]", float64(i)/100, float64(j)/100))
+		}
+		for _, l := range []string{strings.Join(h, ", "), strings.Join(v, ", ")} {
+			lines = append(lines, "{ \"type\": \"Feature\", \"properties\": { \"speed\": 60 }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": ["+l+"] } }")
+		}
+	}
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" + strings.Join(lines, ",") + "] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	return fc
+}
+
+func TestIsochrones(t *testing.T) {
+	g, err := New(streets(t), Options{SpeedProperty: "speed"})
+	assert.Nil(t, err)
+
+	block, err := measurement.Distance(0, 0, 0.01, 0, constants.UnitKilometers)
+	assert.Nil(t, err)
+	hours := block / 60
+	fc, err := g.Isochrones(geometry.Point{Lng: 0.02, Lat: 0.02}, []float64{2.5 * hours, 10 * hours}, IsochroneOptions{MaxEdge: common.Float64Ptr(2)})
+	assert.Nil(t, err)
+	assert.Equal(t, len(fc.Features), 2)
+
+	// the nodes within two blocks form a diamond of 8 blocks and the second threshold reaches every node
+	areas := []float64{}
+	for i, f := range fc.Features {
+		assert.Equal(t, f.Geometry.GeoJSONType, geojson.Polygon)
+		assert.Equal(t, f.Properties["value"], []float64{2.5 * hours, 10 * hours}[i])
+		p, err := f.ToPolygon()
+		assert.Nil(t, err)
+		areas = append(areas, math.Abs(planar.SignedArea(p.Coordinates[0].Coordinates))*1e4)
+	}
+	assert.True(t, math.Abs(areas[0]-8) < 1e-6)
+	assert.True(t, math.Abs(areas[1]-16) < 1e-6)
+}
+
+func TestIsochronesConcave(t *testing.T) {
+	// without the middle streets the nodes are only along the sides of the square
+	fc := streets(t)
+	fc.Features = []feature.Feature{fc.Features[0], fc.Features[1], fc.Features[8], fc.Features[9]}
+	g, err := New(fc, Options{})
+	assert.Nil(t, err)
+
+	convex, err := g.Isochrones(geometry.Point{Lng: 0, Lat: 0}, []float64{100}, IsochroneOptions{Property: common.StringPtr("km")})
+	assert.Nil(t, err)
+	p, err := convex.Features[0].ToPolygon()
+	assert.Nil(t, err)
+	assert.Equal(t, len(p.Coordinates), 1)
+	assert.Equal(t, convex.Features[0].Properties["km"], 100.0)
+
+	// only the corners have nodes close enough on both sides
+	concave, err := g.Isochrones(geometry.Point{Lng: 0, Lat: 0}, []float64{100}, IsochroneOptions{MaxEdge: common.Float64Ptr(2)})
+	assert.Nil(t, err)
+	assert.Equal(t, concave.Features[0].Geometry.GeoJSONType, geojson.MultiPolygon)
+	b, err := json.Marshal(concave.Features[0].Geometry.Coordinates)
+	assert.Nil(t, err)
+	var coords [][][][]float64
+	assert.Nil(t, json.Unmarshal(b, &coords))
+	assert.Equal(t, len(coords), 4)
+}
+
+func TestIsochronesErrors(t *testing.T) {
+	g, err := New(streets(t), Options{})
+	assert.Nil(t, err)
+	_, err = g.Isochrones(geometry.Point{}, []float64{2, 1}, IsochroneOptions{})
+	assert.Equal(t, err.Error(), "thresholds must be positive and in ascending order")
+	_, err = g.Isochrones(geometry.Point{}, []float64{1}, IsochroneOptions{MaxEdge: common.Float64Ptr(0)})
+	assert.Equal(t, err.Error(), "max edge must be a positive number")
+
+	_, err = New(streets(t), Options{SpeedProperty: "maxspeed"})
+	assert.Equal(t, err.Error(), "lines must have a positive maxspeed property")
+	_, err = New(streets(t), Options{SpeedProperty: "speed", WeightProperty: "speed"})
+	assert.Equal(t, err.Error(), "lines can't have both a weight and a speed property")
+}
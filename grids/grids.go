@@ -0,0 +1,231 @@
+package grids
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// GridOptions ...
+type GridOptions struct {
+	// Units of the cell side. constants.UnitKilometers is the default value
+	Units string
+	// Properties are copied to every cell of the grid. nil is the default value
+	Properties map[string]interface{}
+}
+
+// PointGrid creates a grid of points within the bbox, spaced cellSide apart.
+// The grid is centered in the bbox.
+//
+// Examples:
+//
+//	fc, err := grids.PointGrid(geojson.BBOX{West: -1, South: -1, East: 1, North: 1}, 50, grids.GridOptions{})
+func PointGrid(bbox geojson.BBOX, cellSide float64, options GridOptions) (*feature.Collection, error) {
+	width, height, err := cellSize(bbox, cellSide, options)
+	if err != nil {
+		return nil, err
+	}
+	columns := math.Floor((bbox.East - bbox.West) / width)
+	rows := math.Floor((bbox.North - bbox.South) / height)
+	dx := (bbox.East - bbox.West - columns*width) / 2
+	dy := (bbox.North - bbox.South - rows*height) / 2
+
+	features := []feature.Feature{}
+	for x := 0; x <= int(columns); x++ {
+		for y := 0; y <= int(rows); y++ {
+			p := geometry.Point{Lng: bbox.West + dx + float64(x)*width, Lat: bbox.South + dy + float64(y)*height}
+			f, err := cell(geojson.Point, common.PointCoords(p), options)
+			if err != nil {
+				return nil, err
+			}
+			features = append(features, *f)
+		}
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// SquareGrid creates a grid of square polygons within the bbox, with sides of cellSide length.
+// The grid is centered in the bbox and only whole cells are returned.
+//
+// Examples:
+//
+//	fc, err := grids.SquareGrid(geojson.BBOX{West: -1, South: -1, East: 1, North: 1}, 50, grids.GridOptions{})
+func SquareGrid(bbox geojson.BBOX, cellSide float64, options GridOptions) (*feature.Collection, error) {
+	width, height, err := cellSize(bbox, cellSide, options)
+	if err != nil {
+		return nil, err
+	}
+	columns := math.Floor((bbox.East - bbox.West) / width)
+	rows := math.Floor((bbox.North - bbox.South) / height)
+	dx := (bbox.East - bbox.West - columns*width) / 2
+	dy := (bbox.North - bbox.South - rows*height) / 2
+
+	features := []feature.Feature{}
+	for x := 0; x < int(columns); x++ {
+		for y := 0; y < int(rows); y++ {
+			w := bbox.West + dx + float64(x)*width
+			s := bbox.South + dy + float64(y)*height
+			f, err := cell(geojson.Polygon, [][][]float64{{{w, s}, {w, s + height}, {w + width, s + height}, {w + width, s}, {w, s}}}, options)
+			if err != nil {
+				return nil, err
+			}
+			features = append(features, *f)
+		}
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// HexGrid creates a grid of flat-topped hexagons within the bbox, whose sides and radius are cellSide long.
+// The grid is centered in the bbox.
+//
+// Examples:
+//
+//	fc, err := grids.HexGrid(geojson.BBOX{West: -1, South: -1, East: 1, North: 1}, 50, grids.GridOptions{})
+func HexGrid(bbox geojson.BBOX, cellSide float64, options GridOptions) (*feature.Collection, error) {
+	if options.Units == "" {
+		options.Units = constants.UnitKilometers
+	}
+	if cellSide <= 0 {
+		return nil, errors.New("cell side must be a positive number")
+	}
+	centerX := (bbox.West + bbox.East) / 2
+	centerY := (bbox.South + bbox.North) / 2
+	xDistance, err := measurement.Distance(bbox.West, centerY, bbox.East, centerY, options.Units)
+	if err != nil {
+		return nil, err
+	}
+	yDistance, err := measurement.Distance(centerX, bbox.South, centerX, bbox.North, options.Units)
+	if err != nil {
+		return nil, err
+	}
+	if xDistance == 0 || yDistance == 0 {
+		return nil, errors.New("bbox must have an area")
+	}
+	cellWidth := cellSide * 2 / xDistance * (bbox.East - bbox.West)
+	cellHeight := cellSide * 2 / yDistance * (bbox.North - bbox.South)
+
+	radius := cellWidth / 2
+	hexWidth := radius * 2
+	hexHeight := math.Sqrt(3) / 2 * cellHeight
+	boxWidth := bbox.East - bbox.West
+	boxHeight := bbox.North - bbox.South
+	xInterval := 3.0 / 4 * hexWidth
+	yInterval := hexHeight
+
+	xCount := int(math.Floor((boxWidth - hexWidth) / (hexWidth - radius/2)))
+	xAdjust := (float64(xCount)*xInterval-radius/2-boxWidth)/2 - radius/2 + xInterval/2
+	yCount := int(math.Floor((boxHeight - hexHeight) / hexHeight))
+	yAdjust := (boxHeight - float64(yCount)*hexHeight) / 2
+	hasOffsetY := float64(yCount)*hexHeight-boxHeight > hexHeight/2
+	if hasOffsetY {
+		yAdjust -= hexHeight / 4
+	}
+
+	features := []feature.Feature{}
+	for x := 0; x <= xCount; x++ {
+		for y := 0; y <= yCount; y++ {
+			odd := x%2 == 1
+			if y == 0 && (odd || hasOffsetY) {
+				continue
+			}
+			cx := float64(x)*xInterval + bbox.West - xAdjust
+			cy := float64(y)*yInterval + bbox.South + yAdjust
+			if odd {
+				cy -= hexHeight / 2
+			}
+
+			ring := [][]float64{}
+			for i := 0; i < 6; i++ {
+				a := 2 * math.Pi / 6 * float64(i)
+				ring = append(ring, []float64{cx + cellWidth/2*math.Cos(a), cy + cellHeight/2*math.Sin(a)})
+			}
+			ring = append(ring, ring[0])
+			f, err := cell(geojson.Polygon, [][][]float64{ring}, options)
+			if err != nil {
+				return nil, err
+			}
+			features = append(features, *f)
+		}
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// TriangleGrid creates a grid of right triangles within the bbox, covering squares with sides of cellSide length
+// that start at the south west corner of the bbox. The diagonals alternate between neighbouring squares.
+//
+// Examples:
+//
+//	fc, err := grids.TriangleGrid(geojson.BBOX{West: -1, South: -1, East: 1, North: 1}, 50, grids.GridOptions{})
+func TriangleGrid(bbox geojson.BBOX, cellSide float64, options GridOptions) (*feature.Collection, error) {
+	width, height, err := cellSize(bbox, cellSide, options)
+	if err != nil {
+		return nil, err
+	}
+
+	features := []feature.Feature{}
+	for x := 0; bbox.West+float64(x)*width <= bbox.East; x++ {
+		for y := 0; bbox.South+float64(y)*height <= bbox.North; y++ {
+			w := bbox.West + float64(x)*width
+			s := bbox.South + float64(y)*height
+			e, n := w+width, s+height
+
+			var t1, t2 [][]float64
+			switch {
+			case x%2 == 0 && y%2 == 0, x%2 == 1 && y%2 == 1:
+				t1 = [][]float64{{w, s}, {w, n}, {e, s}, {w, s}}
+				t2 = [][]float64{{w, n}, {e, n}, {e, s}, {w, n}}
+			default:
+				t1 = [][]float64{{w, s}, {w, n}, {e, n}, {w, s}}
+				t2 = [][]float64{{w, s}, {e, n}, {e, s}, {w, s}}
+			}
+			for _, t := range [][][]float64{t1, t2} {
+				f, err := cell(geojson.Polygon, [][][]float64{t}, options)
+				if err != nil {
+					return nil, err
+				}
+				features = append(features, *f)
+			}
+		}
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// cellSize converts the side of a cell to its width and height in degrees, measured along the south and west edges of the bbox.
+func cellSize(bbox geojson.BBOX, cellSide float64, options GridOptions) (float64, float64, error) {
+	if options.Units == "" {
+		options.Units = constants.UnitKilometers
+	}
+	if cellSide <= 0 {
+		return 0, 0, errors.New("cell side must be a positive number")
+	}
+	xDistance, err := measurement.Distance(bbox.West, bbox.South, bbox.East, bbox.South, options.Units)
+	if err != nil {
+		return 0, 0, err
+	}
+	yDistance, err := measurement.Distance(bbox.West, bbox.South, bbox.West, bbox.North, options.Units)
+	if err != nil {
+		return 0, 0, err
+	}
+	if xDistance == 0 || yDistance == 0 {
+		return 0, 0, errors.New("bbox must have an area")
+	}
+	return cellSide / xDistance * (bbox.East - bbox.West), cellSide / yDistance * (bbox.North - bbox.South), nil
+}
+
+// cell returns a feature of the grid with a copy of the properties of the options.
+func cell(t geojson.OBjectType, coords interface{}, options GridOptions) (*feature.Feature, error) {
+	var properties map[string]interface{}
+	if options.Properties != nil {
+		properties = map[string]interface{}{}
+		for k, v := range options.Properties {
+			properties[k] = v
+		}
+	}
+	return feature.New(geometry.Geometry{GeoJSONType: t, Coordinates: coords}, nil, properties, "")
+}
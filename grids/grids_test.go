@@ -0,0 +1,98 @@
+package grids
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+var bbox = geojson.BBOX{West: 0, South: 0, East: 1, North: 1}
+
+func TestPointGrid(t *testing.T) {
+	side, err := measurement.Distance(0, 0, 0.25, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+	fc, err := PointGrid(bbox, side, GridOptions{Properties: map[string]interface{}{"id": 1}})
+	if err != nil {
+		t.Errorf("PointGrid error %v", err)
+	}
+	assert.Equal(t, len(fc.Features), 25)
+	p, err := fc.Features[6].ToPoint()
+	if err != nil {
+		t.Errorf("ToPoint error %v", err)
+	}
+	assert.True(t, math.Abs(p.Lng-0.25) < 1e-9)
+	assert.True(t, math.Abs(p.Lat-0.25) < 1e-9)
+	assert.Equal(t, fc.Features[6].Properties["id"], 1)
+}
+
+func TestSquareGrid(t *testing.T) {
+	side, err := measurement.Distance(0, 0, 0.3, 0, constants.UnitMiles)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+	fc, err := SquareGrid(bbox, side, GridOptions{Units: constants.UnitMiles})
+	if err != nil {
+		t.Errorf("SquareGrid error %v", err)
+	}
+	assert.Equal(t, len(fc.Features), 9)
+	p, err := fc.Features[0].ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error %v", err)
+	}
+	// the grid is centered in the bbox
+	ring := p.Coordinates[0].Coordinates
+	assert.True(t, math.Abs(ring[0].Lng-0.05) < 1e-9)
+	assert.True(t, math.Abs(ring[2].Lng-0.35) < 1e-9)
+	assert.True(t, math.Abs(ring[0].Lat-ring[0].Lng) < 1e-3)
+}
+
+func TestHexGrid(t *testing.T) {
+	fc, err := HexGrid(bbox, 10, GridOptions{})
+	if err != nil {
+		t.Errorf("HexGrid error %v", err)
+	}
+	assert.True(t, len(fc.Features) > 10)
+	for _, f := range fc.Features {
+		p, err := f.ToPolygon()
+		if err != nil {
+			t.Errorf("ToPolygon error %v", err)
+		}
+		ring := p.Coordinates[0].Coordinates
+		assert.Equal(t, len(ring), 7)
+		assert.Equal(t, ring[0], ring[6])
+		center := (ring[0].Lng + ring[3].Lng) / 2
+		assert.True(t, center > 0 && center < 1)
+		// the radius of the hexagon is the cell side
+		d, _ := measurement.Distance(center, ring[0].Lat, ring[0].Lng, ring[0].Lat, constants.UnitKilometers)
+		assert.True(t, math.Abs(d-10) < 0.01)
+	}
+}
+
+func TestTriangleGrid(t *testing.T) {
+	side, err := measurement.Distance(0, 0, 0.3, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+	fc, err := TriangleGrid(bbox, side, GridOptions{})
+	if err != nil {
+		t.Errorf("TriangleGrid error %v", err)
+	}
+	assert.Equal(t, len(fc.Features), 32)
+	for _, f := range fc.Features {
+		p, _ := f.ToPolygon()
+		assert.Equal(t, len(p.Coordinates[0].Coordinates), 4)
+	}
+}
+
+func TestGridInvalid(t *testing.T) {
+	_, err := SquareGrid(bbox, 0, GridOptions{})
+	assert.Equal(t, err.Error(), "cell side must be a positive number")
+	_, err = PointGrid(geojson.BBOX{West: 1, South: 0, East: 1, North: 1}, 1, GridOptions{})
+	assert.Equal(t, err.Error(), "bbox must have an area")
+}
@@ -0,0 +1,69 @@
+package helpers
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+// FeatureOptions ...
+type FeatureOptions struct {
+	// ID is the identifier of the feature. "" is the default value
+	ID string
+	// BBox is the bounding box given as [west, south, east, north] or [west, south, minAltitude, east, north, maxAltitude]. nil is the default value
+	BBox []float64
+}
+
+// Collection is a FeatureCollection with the optional id and bbox members.
+type Collection struct {
+	ID   string    `json:"id,omitempty"`
+	Bbox []float64 `json:"bbox,omitempty"`
+	feature.Collection
+}
+
+// Feature wraps a geometry in a Feature with the given properties, id and bbox.
+// geom can be a Geometry or any geometry type.
+//
+// Examples:
+//
+//	f, err := helpers.Feature(&geometry.Point{Lng: 23.7, Lat: 37.9}, map[string]interface{}{"name": "Athens"}, FeatureOptions{ID: "ath"})
+func Feature(geom interface{}, properties map[string]interface{}, options FeatureOptions) (*feature.Feature, error) {
+	if err := validateBBox(options.BBox); err != nil {
+		return nil, err
+	}
+	g, err := common.ToGeometry(geom)
+	if err != nil {
+		return nil, err
+	}
+	return feature.New(*g, options.BBox, properties, options.ID)
+}
+
+// FeatureCollection returns a FeatureCollection of the features with the given id and bbox.
+// The embedded feature.Collection can be passed to the functions that accept a FeatureCollection.
+//
+// Examples:
+//
+//	fc, err := helpers.FeatureCollection(features, FeatureOptions{BBox: []float64{-10, -10, 10, 10}})
+//	res, err := conversions.Explode(&fc.Collection)
+func FeatureCollection(features []feature.Feature, options FeatureOptions) (*Collection, error) {
+	if err := validateBBox(options.BBox); err != nil {
+		return nil, err
+	}
+	if features == nil {
+		features = []feature.Feature{}
+	}
+	return &Collection{
+		ID:         options.ID,
+		Bbox:       options.BBox,
+		Collection: feature.Collection{Type: geojson.FeatureCollection, Features: features},
+	}, nil
+}
+
+func validateBBox(bbox []float64) error {
+	if bbox != nil && len(bbox) != 4 && len(bbox) != 6 {
+		return errors.New("bbox must have 4 or 6 values")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+)
+
+func TestFeature(t *testing.T) {
+	poly := &geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}}}}}
+	f, err := Feature(poly, map[string]interface{}{"name": "a"}, FeatureOptions{ID: "p1", BBox: []float64{0, 0, 1, 1}})
+	if err != nil {
+		t.Errorf("Feature error %v", err)
+	}
+	assert.Equal(t, f.ID, "p1")
+	assert.Equal(t, f.Type, geojson.Feature)
+	assert.Equal(t, f.Bbox, []float64{0, 0, 1, 1})
+	assert.Equal(t, f.Properties["name"], "a")
+	assert.Equal(t, f.Geometry.GeoJSONType, geojson.Polygon)
+	assert.Equal(t, f.Geometry.Coordinates, [][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}})
+
+	_, err = Feature(poly, nil, FeatureOptions{BBox: []float64{0, 0, 1}})
+	assert.Equal(t, err.Error(), "bbox must have 4 or 6 values")
+}
+
+func TestFeatureCollection(t *testing.T) {
+	f, err := Feature(&geometry.Point{Lng: 1, Lat: 2}, nil, FeatureOptions{})
+	if err != nil {
+		t.Errorf("Feature error %v", err)
+	}
+	fc, err := FeatureCollection([]feature.Feature{*f}, FeatureOptions{ID: "c1", BBox: []float64{1, 2, 1, 2}})
+	if err != nil {
+		t.Errorf("FeatureCollection error %v", err)
+	}
+	assert.Equal(t, fc.Type, geojson.FeatureCollection)
+	assert.Equal(t, len(fc.Features), 1)
+
+	b, err := json.Marshal(fc)
+	if err != nil {
+		t.Errorf("Marshal error %v", err)
+	}
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Errorf("Unmarshal error %v", err)
+	}
+	assert.Equal(t, m["id"], "c1")
+	assert.Equal(t, m["type"], "FeatureCollection")
+	assert.Equal(t, m["bbox"], []interface{}{1.0, 2.0, 1.0, 2.0})
+	assert.Equal(t, len(m["features"].([]interface{})), 1)
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// CloneGeometry copies the coordinates of a geometry type so that the original is left untouched.
+func CloneGeometry(geojson interface{}) interface{} {
+	switch gtp := geojson.(type) {
+	case *geometry.Point:
+		p := *gtp
+		return &p
+	case *geometry.MultiPoint:
+		return &geometry.MultiPoint{Coordinates: clonePoints(gtp.Coordinates)}
+	case *geometry.LineString:
+		return &geometry.LineString{Coordinates: clonePoints(gtp.Coordinates)}
+	case *geometry.MultiLineString:
+		ml := geometry.MultiLineString{}
+		for _, l := range gtp.Coordinates {
+			ml.Coordinates = append(ml.Coordinates, geometry.LineString{Coordinates: clonePoints(l.Coordinates)})
+		}
+		return &ml
+	case *geometry.Polygon:
+		return clonePolygon(*gtp)
+	case *geometry.MultiPolygon:
+		mp := geometry.MultiPolygon{}
+		for _, p := range gtp.Coordinates {
+			mp.Coordinates = append(mp.Coordinates, *clonePolygon(p))
+		}
+		return &mp
+	}
+	return geojson
+}
+
+func clonePoints(coords []geometry.Point) []geometry.Point {
+	return append([]geometry.Point{}, coords...)
+}
+
+func clonePolygon(p geometry.Polygon) *geometry.Polygon {
+	c := geometry.Polygon{}
+	for _, r := range p.Coordinates {
+		c.Coordinates = append(c.Coordinates, geometry.LineString{Coordinates: clonePoints(r.Coordinates)})
+	}
+	return &c
+}
+
+// CloneFeature copies the feature so that replacing its geometry coordinates doesn't change the original.
+func CloneFeature(f *feature.Feature) *feature.Feature {
+	c := *f
+	if f.Properties != nil {
+		c.Properties = map[string]interface{}{}
+		for k, v := range f.Properties {
+			c.Properties[k] = v
+		}
+	}
+	if f.Bbox != nil {
+		c.Bbox = append([]float64{}, f.Bbox...)
+	}
+	return &c
+}
+
+// CloneCollection copies the features of the collection.
+func CloneCollection(fc *feature.Collection) *feature.Collection {
+	c := *fc
+	c.Features = []feature.Feature{}
+	for i := range fc.Features {
+		c.Features = append(c.Features, *CloneFeature(&fc.Features[i]))
+	}
+	return &c
+}
+
+// CloneGeometryCollection copies the geometries of the collection.
+func CloneGeometryCollection(gc *geometry.Collection) *geometry.Collection {
+	c := *gc
+	c.Geometries = append([]geometry.Geometry{}, gc.Geometries...)
+	return &c
+}
@@ -1,5 +1,12 @@
 package common
 
+import "math"
+
+// NormalizeLng moves the longitude by whole turns into [-180, 180).
+func NormalizeLng(lng float64) float64 {
+	return lng - 360*math.Floor((lng+180)/360)
+}
+
 // Float64Ptr returns the pointer to a float64.
 func Float64Ptr(v float64) *float64 {
 	return &v
@@ -0,0 +1,40 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/turf-go/assert"
+)
+
+func TestNormalizeLng(t *testing.T) {
+	tests := map[string]struct {
+		lng  float64
+		want float64
+	}{
+		"in range": {
+			lng:  -120,
+			want: -120,
+		},
+		"one turn east": {
+			lng:  190,
+			want: -170,
+		},
+		"several turns east": {
+			lng:  910,
+			want: -170,
+		},
+		"several turns west": {
+			lng:  -910,
+			want: 170,
+		},
+		"antimeridian": {
+			lng:  180,
+			want: -180,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, NormalizeLng(tt.lng), tt.want)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package common
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// RawPosition reads the coordinates of a Point, either decoded from JSON as []interface{} or built
+// as a slice of numbers. Non-finite values are kept as they are.
+func RawPosition(coords interface{}) ([]float64, error) {
+	return position(reflect.ValueOf(coords))
+}
+
+// RawLine reads the coordinates of a MultiPoint or a LineString.
+func RawLine(coords interface{}) ([][]float64, error) {
+	return line(reflect.ValueOf(coords))
+}
+
+// RawPolygon reads the coordinates of a MultiLineString or a Polygon.
+func RawPolygon(coords interface{}) ([][][]float64, error) {
+	return polygon(reflect.ValueOf(coords))
+}
+
+// RawMultiPolygon reads the coordinates of a MultiPolygon.
+func RawMultiPolygon(coords interface{}) ([][][][]float64, error) {
+	v, err := slice(reflect.ValueOf(coords))
+	if err != nil {
+		return nil, err
+	}
+	res := make([][][][]float64, v.Len())
+	for i := range res {
+		if res[i], err = polygon(v.Index(i)); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// RawCoordinates reads the coordinates of the geometry as []float64, [][]float64, [][][]float64 or [][][][]float64,
+// depending on its type.
+func RawCoordinates(g *geometry.Geometry) (interface{}, error) {
+	switch g.GeoJSONType {
+	case geojson.Point:
+		return RawPosition(g.Coordinates)
+	case geojson.MultiPoint, geojson.LineString:
+		return RawLine(g.Coordinates)
+	case geojson.MultiLineString, geojson.Polygon:
+		return RawPolygon(g.Coordinates)
+	case geojson.MultiPolygon:
+		return RawMultiPolygon(g.Coordinates)
+	}
+	return nil, errors.New("unsupported geometry type")
+}
+
+// ToFloat converts a property value of any numeric type to a float64 and reports whether it is a number.
+func ToFloat(v interface{}) (float64, bool) {
+	return number(reflect.ValueOf(v))
+}
+
+func polygon(v reflect.Value) ([][][]float64, error) {
+	v, err := slice(v)
+	if err != nil {
+		return nil, err
+	}
+	res := make([][][]float64, v.Len())
+	for i := range res {
+		if res[i], err = line(v.Index(i)); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func line(v reflect.Value) ([][]float64, error) {
+	v, err := slice(v)
+	if err != nil {
+		return nil, err
+	}
+	res := make([][]float64, v.Len())
+	for i := range res {
+		if res[i], err = position(v.Index(i)); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func position(v reflect.Value) ([]float64, error) {
+	v, err := slice(v)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]float64, v.Len())
+	for i := range res {
+		f, ok := number(v.Index(i))
+		if !ok {
+			return nil, errors.New("invalid coordinates")
+		}
+		res[i] = f
+	}
+	return res, nil
+}
+
+// slice unwraps the interfaces around a slice.
+func slice(v reflect.Value) (reflect.Value, error) {
+	for v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return v, errors.New("invalid coordinates")
+	}
+	return v, nil
+}
+
+// number converts a value of any numeric kind to a float64.
+func number(v reflect.Value) (float64, bool) {
+	for v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	}
+	return 0, false
+}
@@ -0,0 +1,159 @@
+package common
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// EachGeometry applies the callbackFn to every Geometry of a FeatureCollection, Feature, GeometryCollection or Geometry.
+// The geometry types are converted to a Geometry and their coordinates are read back after the callbackFn.
+// The GeoJSON object is cloned first unless mutate is set, and the returned object has the same type.
+func EachGeometry(geojson interface{}, mutate bool, callbackFn func(*geometry.Geometry) error) (interface{}, error) {
+	switch gtp := geojson.(type) {
+	case *feature.Collection:
+		if !mutate {
+			gtp = CloneCollection(gtp)
+		}
+		for i := range gtp.Features {
+			if err := callbackFn(&gtp.Features[i].Geometry); err != nil {
+				return nil, err
+			}
+		}
+		return gtp, nil
+	case *feature.Feature:
+		if !mutate {
+			gtp = CloneFeature(gtp)
+		}
+		if err := callbackFn(&gtp.Geometry); err != nil {
+			return nil, err
+		}
+		return gtp, nil
+	case *geometry.Collection:
+		if !mutate {
+			gtp = CloneGeometryCollection(gtp)
+		}
+		for i := range gtp.Geometries {
+			if err := callbackFn(&gtp.Geometries[i]); err != nil {
+				return nil, err
+			}
+		}
+		return gtp, nil
+	case *geometry.Geometry:
+		if !mutate {
+			g := *gtp
+			gtp = &g
+		}
+		if err := callbackFn(gtp); err != nil {
+			return nil, err
+		}
+		return gtp, nil
+	}
+
+	g, err := ToGeometry(geojson)
+	if err != nil {
+		return nil, errors.New("unsupported geojson type")
+	}
+	if err := callbackFn(g); err != nil {
+		return nil, err
+	}
+	if !mutate {
+		geojson = CloneGeometry(geojson)
+	}
+	if err := setCoordinates(geojson, g); err != nil {
+		return nil, err
+	}
+	return geojson, nil
+}
+
+// setCoordinates replaces the coordinates of the geometry type t with the coordinates of the Geometry g.
+func setCoordinates(t interface{}, g *geometry.Geometry) error {
+	switch gtp := t.(type) {
+	case *geometry.Point:
+		pos, err := RawPosition(g.Coordinates)
+		if err != nil {
+			return err
+		}
+		p, err := toPoint(pos)
+		if err != nil {
+			return err
+		}
+		*gtp = p
+	case *geometry.MultiPoint:
+		coords, err := RawLine(g.Coordinates)
+		if err != nil {
+			return err
+		}
+		if gtp.Coordinates, err = toPoints(coords); err != nil {
+			return err
+		}
+	case *geometry.LineString:
+		coords, err := RawLine(g.Coordinates)
+		if err != nil {
+			return err
+		}
+		if gtp.Coordinates, err = toPoints(coords); err != nil {
+			return err
+		}
+	case *geometry.MultiLineString:
+		coords, err := RawPolygon(g.Coordinates)
+		if err != nil {
+			return err
+		}
+		if gtp.Coordinates, err = toLines(coords); err != nil {
+			return err
+		}
+	case *geometry.Polygon:
+		coords, err := RawPolygon(g.Coordinates)
+		if err != nil {
+			return err
+		}
+		if gtp.Coordinates, err = toLines(coords); err != nil {
+			return err
+		}
+	case *geometry.MultiPolygon:
+		coords, err := RawMultiPolygon(g.Coordinates)
+		if err != nil {
+			return err
+		}
+		gtp.Coordinates = make([]geometry.Polygon, len(coords))
+		for i := range coords {
+			if gtp.Coordinates[i].Coordinates, err = toLines(coords[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func toLines(coords [][][]float64) ([]geometry.LineString, error) {
+	res := make([]geometry.LineString, len(coords))
+	for i := range coords {
+		pts, err := toPoints(coords[i])
+		if err != nil {
+			return nil, err
+		}
+		res[i] = geometry.LineString{Coordinates: pts}
+	}
+	return res, nil
+}
+
+func toPoints(coords [][]float64) ([]geometry.Point, error) {
+	res := make([]geometry.Point, len(coords))
+	for i := range coords {
+		p, err := toPoint(coords[i])
+		if err != nil {
+			return nil, err
+		}
+		res[i] = p
+	}
+	return res, nil
+}
+
+func toPoint(pos []float64) (geometry.Point, error) {
+	if len(pos) < 2 {
+		return geometry.Point{}, errors.New("a position must have at least two coordinates")
+	}
+	return geometry.Point{Lng: pos[0], Lat: pos[1]}, nil
+}
@@ -0,0 +1,246 @@
+package common
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// Polygons unwraps the polygons of a Polygon or MultiPolygon given as a Feature, Geometry or geometry type.
+func Polygons(t interface{}) ([]geometry.Polygon, error) {
+	switch gtp := t.(type) {
+	case *feature.Feature:
+		return Polygons(&gtp.Geometry)
+	case *geometry.Geometry:
+		switch gtp.GeoJSONType {
+		case geojson.Polygon:
+			p, err := gtp.ToPolygon()
+			if err != nil {
+				return nil, err
+			}
+			return []geometry.Polygon{*p}, nil
+		case geojson.MultiPolygon:
+			mp, err := gtp.ToMultiPolygon()
+			if err != nil {
+				return nil, err
+			}
+			return mp.Coordinates, nil
+		}
+	case *geometry.Polygon:
+		return []geometry.Polygon{*gtp}, nil
+	case *geometry.MultiPolygon:
+		return gtp.Coordinates, nil
+	}
+	return nil, errors.New("geometry must be a Polygon or a MultiPolygon")
+}
+
+// RawPolygons unwraps the polygons like Polygons but without validating the rings,
+// so that unclosed rings or rings with too few positions can still be read from a Feature or Geometry.
+func RawPolygons(t interface{}) ([]geometry.Polygon, error) {
+	var g *geometry.Geometry
+	switch gtp := t.(type) {
+	case *feature.Feature:
+		g = &gtp.Geometry
+	case *geometry.Geometry:
+		g = gtp
+	default:
+		return Polygons(t)
+	}
+
+	var coords [][][][]float64
+	switch g.GeoJSONType {
+	case geojson.Polygon:
+		c, err := RawPolygon(g.Coordinates)
+		if err != nil {
+			return nil, err
+		}
+		coords = append(coords, c)
+	case geojson.MultiPolygon:
+		c, err := RawMultiPolygon(g.Coordinates)
+		if err != nil {
+			return nil, err
+		}
+		coords = c
+	default:
+		return nil, errors.New("geometry must be a Polygon or a MultiPolygon")
+	}
+
+	polys := []geometry.Polygon{}
+	for _, p := range coords {
+		poly := geometry.Polygon{}
+		for _, r := range p {
+			ring := geometry.LineString{}
+			for _, c := range r {
+				if len(c) < 2 {
+					return nil, errors.New("a position must have at least two coordinates")
+				}
+				ring.Coordinates = append(ring.Coordinates, geometry.Point{Lng: c[0], Lat: c[1]})
+			}
+			poly.Coordinates = append(poly.Coordinates, ring)
+		}
+		polys = append(polys, poly)
+	}
+	return polys, nil
+}
+
+// Lines unwraps the lines of a LineString or MultiLineString given as a Feature, Geometry or geometry type.
+func Lines(t interface{}) ([]geometry.LineString, error) {
+	switch gtp := t.(type) {
+	case *feature.Feature:
+		return Lines(&gtp.Geometry)
+	case *geometry.Geometry:
+		switch gtp.GeoJSONType {
+		case geojson.LineString:
+			l, err := gtp.ToLineString()
+			if err != nil {
+				return nil, err
+			}
+			return []geometry.LineString{*l}, nil
+		case geojson.MultiLineString:
+			// a MultiLineString can have a single line, which ToMultiLineString rejects
+			coords, err := RawPolygon(gtp.Coordinates)
+			if err != nil {
+				return nil, err
+			}
+			return toLines(coords)
+		}
+	case *geometry.LineString:
+		return []geometry.LineString{*gtp}, nil
+	case *geometry.MultiLineString:
+		return gtp.Coordinates, nil
+	}
+	return nil, errors.New("geometry must be a LineString or a MultiLineString")
+}
+
+// ToGeometry converts a geometry type to a Geometry with GeoJSON coordinates. A Geometry is returned as it is.
+func ToGeometry(t interface{}) (*geometry.Geometry, error) {
+	switch gtp := t.(type) {
+	case *geometry.Geometry:
+		return gtp, nil
+	case *geometry.Point:
+		return &geometry.Geometry{GeoJSONType: geojson.Point, Coordinates: PointCoords(*gtp)}, nil
+	case *geometry.MultiPoint:
+		return &geometry.Geometry{GeoJSONType: geojson.MultiPoint, Coordinates: LineStringCoords(gtp.Coordinates)}, nil
+	case *geometry.LineString:
+		return &geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: LineStringCoords(gtp.Coordinates)}, nil
+	case *geometry.MultiLineString:
+		return &geometry.Geometry{GeoJSONType: geojson.MultiLineString, Coordinates: MultiLineStringCoords(gtp.Coordinates)}, nil
+	case *geometry.Polygon:
+		return &geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: PolygonCoords(*gtp)}, nil
+	case *geometry.MultiPolygon:
+		return &geometry.Geometry{GeoJSONType: geojson.MultiPolygon, Coordinates: MultiPolygonCoords(gtp.Coordinates)}, nil
+	}
+	return nil, errors.New("unknown geometry type")
+}
+
+// PointCoords returns the GeoJSON coordinates of a point.
+func PointCoords(p geometry.Point) []float64 {
+	return []float64{p.Lng, p.Lat}
+}
+
+// LineStringCoords returns the GeoJSON coordinates of a sequence of points.
+func LineStringCoords(pts []geometry.Point) [][]float64 {
+	coords := [][]float64{}
+	for _, p := range pts {
+		coords = append(coords, PointCoords(p))
+	}
+	return coords
+}
+
+// MultiLineStringCoords returns the GeoJSON coordinates of a MultiLineString.
+func MultiLineStringCoords(lines []geometry.LineString) [][][]float64 {
+	coords := [][][]float64{}
+	for _, l := range lines {
+		coords = append(coords, LineStringCoords(l.Coordinates))
+	}
+	return coords
+}
+
+// PolygonCoords returns the GeoJSON coordinates of a Polygon.
+func PolygonCoords(p geometry.Polygon) [][][]float64 {
+	return MultiLineStringCoords(p.Coordinates)
+}
+
+// MultiPolygonCoords returns the GeoJSON coordinates of a MultiPolygon.
+func MultiPolygonCoords(polys []geometry.Polygon) [][][][]float64 {
+	coords := [][][][]float64{}
+	for _, p := range polys {
+		coords = append(coords, PolygonCoords(p))
+	}
+	return coords
+}
+
+// EachPosition iterates over the raw positions of a geometry, including their altitude, and replaces
+// the coordinates of the geometry with the positions returned by the callbackFn.
+func EachPosition(g *geometry.Geometry, callbackFn func([]float64) []float64) error {
+	coords, err := RawCoordinates(g)
+	if err != nil {
+		return err
+	}
+
+	switch c := coords.(type) {
+	case []float64:
+		g.Coordinates = callbackFn(c)
+	case [][]float64:
+		for i := range c {
+			c[i] = callbackFn(c[i])
+		}
+		g.Coordinates = c
+	case [][][]float64:
+		for i := range c {
+			for j := range c[i] {
+				c[i][j] = callbackFn(c[i][j])
+			}
+		}
+		g.Coordinates = c
+	case [][][][]float64:
+		for i := range c {
+			for j := range c[i] {
+				for k := range c[i][j] {
+					c[i][j][k] = callbackFn(c[i][j][k])
+				}
+			}
+		}
+		g.Coordinates = c
+	}
+	return nil
+}
+
+// EachLine iterates over the raw position sequences of a geometry, the LineStrings and the rings of the Polygons,
+// and replaces them with the positions returned by the callbackFn. Points and MultiPoints are left untouched.
+func EachLine(g *geometry.Geometry, callbackFn func([][]float64) ([][]float64, error)) error {
+	if g.GeoJSONType == geojson.Point || g.GeoJSONType == geojson.MultiPoint {
+		return nil
+	}
+	coords, err := RawCoordinates(g)
+	if err != nil {
+		return err
+	}
+
+	switch c := coords.(type) {
+	case [][]float64:
+		if c, err = callbackFn(c); err != nil {
+			return err
+		}
+		g.Coordinates = c
+	case [][][]float64:
+		for i := range c {
+			if c[i], err = callbackFn(c[i]); err != nil {
+				return err
+			}
+		}
+		g.Coordinates = c
+	case [][][][]float64:
+		for i := range c {
+			for j := range c[i] {
+				if c[i][j], err = callbackFn(c[i][j]); err != nil {
+					return err
+				}
+			}
+		}
+		g.Coordinates = c
+	}
+	return nil
+}
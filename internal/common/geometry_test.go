@@ -0,0 +1,44 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+)
+
+func TestLines(t *testing.T) {
+	tests := map[string]struct {
+		geojson string
+		want    []geometry.LineString
+	}{
+		"line string": {
+			geojson: "{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [1, 1]] } }",
+			want:    []geometry.LineString{{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}}}},
+		},
+		"multi line string": {
+			geojson: "{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"MultiLineString\", \"coordinates\": [[[0, 0], [1, 1]], [[2, 2], [3, 3]]] } }",
+			want: []geometry.LineString{
+				{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}}},
+				{Coordinates: []geometry.Point{{Lng: 2, Lat: 2}, {Lng: 3, Lat: 3}}},
+			},
+		},
+		"multi line string with one line": {
+			geojson: "{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"MultiLineString\", \"coordinates\": [[[0, 0], [1, 1, 5]]] } }",
+			want:    []geometry.LineString{{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}}}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := feature.FromJSON(tt.geojson)
+			assert.Nil(t, err)
+			got, err := Lines(f)
+			assert.Nil(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+
+	_, err := Lines(&geometry.Point{})
+	assert.Equal(t, err.Error(), "geometry must be a LineString or a MultiLineString")
+}
@@ -0,0 +1,375 @@
+package planar
+
+import (
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// Tolerance is the distance in degrees below which two nodes of a Graph are merged.
+const Tolerance = 1e-9
+
+// Graph is a planar graph built from a set of segments which are split at every intersection,
+// so that edges only meet at their end points.
+type Graph struct {
+	Nodes []geometry.Point
+	// Edges are the unique undirected edges as pairs of node indices.
+	Edges [][2]int
+	keys  map[[2]int64]int
+}
+
+// Node splits the segments at their intersections and returns the planar graph of the result.
+func Node(segs []Segment) *Graph {
+	g := &Graph{keys: map[[2]int64]int{}}
+	splits := make([][]geometry.Point, len(segs))
+	for i, s := range segs {
+		splits[i] = []geometry.Point{s.A, s.B}
+		// register the input vertices first so that they are preferred over computed intersections
+		g.node(s.A)
+		g.node(s.B)
+	}
+
+	// sweep the segments ordered by their minimum longitude
+	order := make([]int, len(segs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return math.Min(segs[order[i]].A.Lng, segs[order[i]].B.Lng) < math.Min(segs[order[j]].A.Lng, segs[order[j]].B.Lng)
+	})
+	for i := 0; i < len(order); i++ {
+		s1 := segs[order[i]]
+		maxX := math.Max(s1.A.Lng, s1.B.Lng)
+		for j := i + 1; j < len(order); j++ {
+			s2 := segs[order[j]]
+			if math.Min(s2.A.Lng, s2.B.Lng) > maxX {
+				break
+			}
+			if math.Max(s1.A.Lat, s1.B.Lat) < math.Min(s2.A.Lat, s2.B.Lat) || math.Max(s2.A.Lat, s2.B.Lat) < math.Min(s1.A.Lat, s1.B.Lat) {
+				continue
+			}
+			for _, p := range Intersection(s1, s2) {
+				splits[order[i]] = append(splits[order[i]], p)
+				splits[order[j]] = append(splits[order[j]], p)
+			}
+		}
+	}
+
+	seen := map[[2]int]bool{}
+	for i, s := range segs {
+		pts := splits[i]
+		d := sub(s.B, s.A)
+		sort.Slice(pts, func(a, b int) bool {
+			return (pts[a].Lng-s.A.Lng)*d.Lng+(pts[a].Lat-s.A.Lat)*d.Lat < (pts[b].Lng-s.A.Lng)*d.Lng+(pts[b].Lat-s.A.Lat)*d.Lat
+		})
+		for k := 0; k < len(pts)-1; k++ {
+			u := g.node(pts[k])
+			v := g.node(pts[k+1])
+			if u == v {
+				continue
+			}
+			key := [2]int{u, v}
+			if u > v {
+				key = [2]int{v, u}
+			}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			g.Edges = append(g.Edges, [2]int{u, v})
+		}
+	}
+	return g
+}
+
+// node returns the index of the node at the given position, creating it if it doesn't exist.
+func (g *Graph) node(p geometry.Point) int {
+	key := [2]int64{int64(math.Round(p.Lng / Tolerance)), int64(math.Round(p.Lat / Tolerance))}
+	if i, ok := g.keys[key]; ok {
+		return i
+	}
+	g.Nodes = append(g.Nodes, p)
+	g.keys[key] = len(g.Nodes) - 1
+	return len(g.Nodes) - 1
+}
+
+// Overlay classifies the area on both sides of every edge with the inside function and returns
+// the polygons formed by the edges that separate the inside from the outside.
+// Outer rings are oriented counter-clockwise and holes clockwise.
+func (g *Graph) Overlay(inside func(geometry.Point) bool) []geometry.Polygon {
+	offsets := g.sampleOffsets()
+	directed := [][2]int{}
+	for i, e := range g.Edges {
+		a := g.Nodes[e[0]]
+		b := g.Nodes[e[1]]
+		l := math.Hypot(b.Lng-a.Lng, b.Lat-a.Lat)
+		mid := geometry.Point{Lng: (a.Lng + b.Lng) / 2, Lat: (a.Lat + b.Lat) / 2}
+		nx := -(b.Lat - a.Lat) / l * offsets[i]
+		ny := (b.Lng - a.Lng) / l * offsets[i]
+		left := inside(geometry.Point{Lng: mid.Lng + nx, Lat: mid.Lat + ny})
+		right := inside(geometry.Point{Lng: mid.Lng - nx, Lat: mid.Lat - ny})
+		if left && !right {
+			directed = append(directed, e)
+		} else if right && !left {
+			directed = append(directed, [2]int{e[1], e[0]})
+		}
+	}
+	return g.assemble(g.trace(directed))
+}
+
+// Faces returns the polygons enclosed by the edges of the graph.
+// Dangling edges are ignored and enclosed faces are returned as holes of their surrounding face.
+func (g *Graph) Faces() []geometry.Polygon {
+	degree := make([]int, len(g.Nodes))
+	for _, e := range g.Edges {
+		degree[e[0]]++
+		degree[e[1]]++
+	}
+	removed := make([]bool, len(g.Edges))
+	for changed := true; changed; {
+		changed = false
+		for i, e := range g.Edges {
+			if !removed[i] && (degree[e[0]] < 2 || degree[e[1]] < 2) {
+				removed[i] = true
+				degree[e[0]]--
+				degree[e[1]]--
+				changed = true
+			}
+		}
+	}
+
+	directed := [][2]int{}
+	for i, e := range g.Edges {
+		if !removed[i] {
+			directed = append(directed, e, [2]int{e[1], e[0]})
+		}
+	}
+	return g.assemble(g.trace(directed))
+}
+
+// sampleOffsets returns for every edge the distance from its midpoint at which the area on each side is sampled.
+// The offset is kept below half the distance to any other edge so that the samples stay within the adjacent faces.
+func (g *Graph) sampleOffsets() []float64 {
+	offsets := make([]float64, len(g.Edges))
+	if len(g.Edges) == 0 {
+		return offsets
+	}
+
+	segs := make([]Segment, len(g.Edges))
+	total := 0.0
+	for i, e := range g.Edges {
+		segs[i] = Segment{A: g.Nodes[e[0]], B: g.Nodes[e[1]]}
+		total += math.Hypot(segs[i].B.Lng-segs[i].A.Lng, segs[i].B.Lat-segs[i].A.Lat)
+	}
+	idx := newIndex(segs, total/float64(len(segs)))
+
+	for i, s := range segs {
+		l := math.Hypot(s.B.Lng-s.A.Lng, s.B.Lat-s.A.Lat)
+		mid := geometry.Point{Lng: (s.A.Lng + s.B.Lng) / 2, Lat: (s.A.Lat + s.B.Lat) / 2}
+		offset := l * 1e-3
+		for _, j := range idx.query(mid, offset) {
+			if j == i {
+				continue
+			}
+			if d := Distance(mid, segs[j]); d/2 < offset {
+				offset = d / 2
+			}
+		}
+		offsets[i] = offset
+	}
+	return offsets
+}
+
+// trace links directed edges into closed rings of node indices, keeping the area on the left of each edge
+// inside the ring. Rings that touch themselves are split at the touching nodes.
+func (g *Graph) trace(directed [][2]int) [][]int {
+	out := map[int][]int{}
+	angles := make([]float64, len(directed))
+	for i, e := range directed {
+		out[e[0]] = append(out[e[0]], i)
+		a := g.Nodes[e[0]]
+		b := g.Nodes[e[1]]
+		angles[i] = math.Atan2(b.Lat-a.Lat, b.Lng-a.Lng)
+	}
+
+	used := make([]bool, len(directed))
+	rings := [][]int{}
+	for s := range directed {
+		if used[s] {
+			continue
+		}
+		ids := []int{}
+		closed := false
+		for e := s; ; {
+			used[e] = true
+			ids = append(ids, directed[e][0])
+			next := g.nextEdge(e, directed, out[directed[e][1]], angles)
+			if next == s {
+				closed = true
+				break
+			}
+			if next < 0 || used[next] {
+				break
+			}
+			e = next
+		}
+		if closed {
+			rings = append(rings, splitRing(ids)...)
+		}
+	}
+	return rings
+}
+
+// nextEdge chooses among the candidates leaving the end node of the edge the one that is reached first
+// when turning clockwise from the edge back to its start node, so that the traced rings enclose the smallest area.
+func (g *Graph) nextEdge(e int, directed [][2]int, candidates []int, angles []float64) int {
+	back := angles[e] + math.Pi
+	best := -1
+	bestTurn := math.Inf(1)
+	for _, c := range candidates {
+		turn := math.Mod(back-angles[c], 2*math.Pi)
+		if turn <= 0 {
+			turn += 2 * math.Pi
+		}
+		if directed[c][1] == directed[e][0] {
+			// only walk back along the same edge when there is no other way
+			turn = 2 * math.Pi
+		}
+		if turn < bestTurn {
+			bestTurn = turn
+			best = c
+		}
+	}
+	return best
+}
+
+// splitRing splits a ring of node indices that visits a node more than once into simple rings.
+func splitRing(ids []int) [][]int {
+	rings := [][]int{}
+	stack := []int{}
+	pos := map[int]int{}
+	for _, id := range ids {
+		if p, ok := pos[id]; ok {
+			ring := append([]int{}, stack[p:]...)
+			for _, r := range ring[1:] {
+				delete(pos, r)
+			}
+			stack = stack[:p+1]
+			if len(ring) > 2 {
+				rings = append(rings, ring)
+			}
+			continue
+		}
+		pos[id] = len(stack)
+		stack = append(stack, id)
+	}
+	if len(stack) > 2 {
+		rings = append(rings, stack)
+	}
+	return rings
+}
+
+// assemble builds polygons from rings of node indices. Counter-clockwise rings become outer rings and
+// clockwise rings become holes of the smallest outer ring that contains them.
+func (g *Graph) assemble(rings [][]int) []geometry.Polygon {
+	type ring struct {
+		ids    []int
+		coords []geometry.Point
+		area   float64
+		holes  [][]geometry.Point
+	}
+
+	shells := []*ring{}
+	holes := []*ring{}
+	for _, ids := range rings {
+		r := &ring{ids: ids}
+		for _, id := range ids {
+			r.coords = append(r.coords, g.Nodes[id])
+		}
+		r.coords = append(r.coords, g.Nodes[ids[0]])
+		r.area = SignedArea(r.coords)
+		if r.area > 0 {
+			shells = append(shells, r)
+		} else if r.area < 0 {
+			holes = append(holes, r)
+		}
+	}
+
+	for _, h := range holes {
+		edges := map[[2]int]bool{}
+		for i := range h.ids {
+			edges[[2]int{h.ids[(i+1)%len(h.ids)], h.ids[i]}] = true
+		}
+		a := h.coords[0]
+		b := h.coords[1]
+		mid := geometry.Point{Lng: (a.Lng + b.Lng) / 2, Lat: (a.Lat + b.Lat) / 2}
+
+		var owner *ring
+		for _, s := range shells {
+			if owner != nil && s.area >= owner.area {
+				continue
+			}
+			// skip the faces that share the boundary of the hole
+			shared := false
+			for i := range s.ids {
+				if edges[[2]int{s.ids[i], s.ids[(i+1)%len(s.ids)]}] {
+					shared = true
+					break
+				}
+			}
+			if !shared && InRing(mid, s.coords) {
+				owner = s
+			}
+		}
+		if owner != nil {
+			owner.holes = append(owner.holes, h.coords)
+		}
+	}
+
+	polys := []geometry.Polygon{}
+	for _, s := range shells {
+		p := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: s.coords}}}
+		for _, h := range s.holes {
+			p.Coordinates = append(p.Coordinates, geometry.LineString{Coordinates: h})
+		}
+		polys = append(polys, p)
+	}
+	return polys
+}
+
+// Union returns the polygons covering the area of all the input polygons.
+func Union(polys []geometry.Polygon) []geometry.Polygon {
+	boxes := make([][4]float64, len(polys))
+	for i, p := range polys {
+		boxes[i] = Bounds(p)
+	}
+	return Node(PolygonSegments(polys)).Overlay(func(pt geometry.Point) bool {
+		for i, p := range polys {
+			if InBounds(pt, boxes[i]) && InPolygon(pt, p) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Bounds returns the bounding box of the outer ring of the polygon as [minX, minY, maxX, maxY].
+func Bounds(p geometry.Polygon) [4]float64 {
+	b := [4]float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	if len(p.Coordinates) == 0 {
+		return b
+	}
+	for _, c := range p.Coordinates[0].Coordinates {
+		b[0] = math.Min(b[0], c.Lng)
+		b[1] = math.Min(b[1], c.Lat)
+		b[2] = math.Max(b[2], c.Lng)
+		b[3] = math.Max(b[3], c.Lat)
+	}
+	return b
+}
+
+// InBounds determines if the point resides inside the bounding box or on its boundary.
+func InBounds(pt geometry.Point, b [4]float64) bool {
+	return pt.Lng >= b[0] && pt.Lat >= b[1] && pt.Lng <= b[2] && pt.Lat <= b[3]
+}
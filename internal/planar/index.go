@@ -0,0 +1,53 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// index is a uniform grid of cells holding the segments that pass through their extent.
+type index struct {
+	size  float64
+	cells map[[2]int64][]int
+}
+
+func newIndex(segs []Segment, size float64) *index {
+	if size <= 0 {
+		size = Tolerance
+	}
+	idx := &index{size: size, cells: map[[2]int64][]int{}}
+	for i, s := range segs {
+		x0, y0 := idx.cell(geometry.Point{Lng: math.Min(s.A.Lng, s.B.Lng), Lat: math.Min(s.A.Lat, s.B.Lat)})
+		x1, y1 := idx.cell(geometry.Point{Lng: math.Max(s.A.Lng, s.B.Lng), Lat: math.Max(s.A.Lat, s.B.Lat)})
+		for x := x0; x <= x1; x++ {
+			for y := y0; y <= y1; y++ {
+				idx.cells[[2]int64{x, y}] = append(idx.cells[[2]int64{x, y}], i)
+			}
+		}
+	}
+	return idx
+}
+
+func (idx *index) cell(p geometry.Point) (int64, int64) {
+	return int64(math.Floor(p.Lng / idx.size)), int64(math.Floor(p.Lat / idx.size))
+}
+
+// query returns the segments registered in the cells within the radius of the point.
+func (idx *index) query(p geometry.Point, radius float64) []int {
+	x0, y0 := idx.cell(geometry.Point{Lng: p.Lng - radius, Lat: p.Lat - radius})
+	x1, y1 := idx.cell(geometry.Point{Lng: p.Lng + radius, Lat: p.Lat + radius})
+	seen := map[int]bool{}
+	res := []int{}
+	for x := x0; x <= x1; x++ {
+		for y := y0; y <= y1; y++ {
+			for _, i := range idx.cells[[2]int64{x, y}] {
+				if !seen[i] {
+					seen[i] = true
+					res = append(res, i)
+				}
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,159 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// Segment is a straight line between two points, measured in the plane of the lon/lat coordinates.
+type Segment struct {
+	A geometry.Point
+	B geometry.Point
+}
+
+// Segments returns the consecutive segments of a sequence of points, skipping zero length segments.
+func Segments(coords []geometry.Point) []Segment {
+	segs := []Segment{}
+	for i := 0; i < len(coords)-1; i++ {
+		if coords[i] == coords[i+1] {
+			continue
+		}
+		segs = append(segs, Segment{A: coords[i], B: coords[i+1]})
+	}
+	return segs
+}
+
+// PolygonSegments returns the segments of every ring of the polygons.
+func PolygonSegments(polys []geometry.Polygon) []Segment {
+	segs := []Segment{}
+	for _, p := range polys {
+		for _, r := range p.Coordinates {
+			segs = append(segs, Segments(r.Coordinates)...)
+		}
+	}
+	return segs
+}
+
+// SignedArea returns the planar area of a ring using the shoelace formula.
+// The area is positive if the ring is oriented counter-clockwise, otherwise it is negative.
+func SignedArea(ring []geometry.Point) float64 {
+	total := 0.0
+	n := len(ring)
+	for i := 0; i < n; i++ {
+		p1 := ring[i]
+		p2 := ring[(i+1)%n]
+		total += p1.Lng*p2.Lat - p2.Lng*p1.Lat
+	}
+	return total / 2.0
+}
+
+// InRing determines if the point resides inside the ring using the even-odd rule.
+// The result for points that lie exactly on the boundary is unspecified.
+func InRing(pt geometry.Point, ring []geometry.Point) bool {
+	isInside := false
+	j := len(ring) - 1
+	for i := 0; i < len(ring); i++ {
+		xi := ring[i].Lng
+		yi := ring[i].Lat
+		xj := ring[j].Lng
+		yj := ring[j].Lat
+
+		if (yi > pt.Lat) != (yj > pt.Lat) && (pt.Lng < (xj-xi)*(pt.Lat-yi)/(yj-yi)+xi) {
+			isInside = !isInside
+		}
+		j = i
+	}
+	return isInside
+}
+
+// InPolygon determines if the point resides inside the outer ring of the polygon and outside all of its holes.
+func InPolygon(pt geometry.Point, poly geometry.Polygon) bool {
+	if len(poly.Coordinates) == 0 || !InRing(pt, poly.Coordinates[0].Coordinates) {
+		return false
+	}
+	for i := 1; i < len(poly.Coordinates); i++ {
+		if InRing(pt, poly.Coordinates[i].Coordinates) {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersection returns the points where two segments meet.
+// Crossing and touching segments meet in a single point, while collinear overlapping segments
+// return the end points of the shared part.
+func Intersection(s1 Segment, s2 Segment) []geometry.Point {
+	d1 := sub(s1.B, s1.A)
+	d2 := sub(s2.B, s2.A)
+	denom := cross(d1, d2)
+	d := sub(s2.A, s1.A)
+
+	if denom == 0 {
+		// parallel segments only meet if they are collinear
+		if cross(d, d1) != 0 {
+			return nil
+		}
+		pts := []geometry.Point{}
+		for _, p := range []geometry.Point{s1.A, s1.B, s2.A, s2.B} {
+			if inSpan(p, s1) && inSpan(p, s2) && !contains(pts, p) {
+				pts = append(pts, p)
+			}
+		}
+		return pts
+	}
+
+	t := cross(d, d2) / denom
+	u := cross(d, d1) / denom
+	if t < 0 || t > 1 || u < 0 || u > 1 {
+		return nil
+	}
+
+	// reuse the exact input coordinates when the segments touch at an end point
+	switch {
+	case t == 0:
+		return []geometry.Point{s1.A}
+	case t == 1:
+		return []geometry.Point{s1.B}
+	case u == 0:
+		return []geometry.Point{s2.A}
+	case u == 1:
+		return []geometry.Point{s2.B}
+	}
+	return []geometry.Point{{Lng: s1.A.Lng + t*d1.Lng, Lat: s1.A.Lat + t*d1.Lat}}
+}
+
+// Distance returns the planar distance from a point to a segment.
+func Distance(p geometry.Point, s Segment) float64 {
+	d := sub(s.B, s.A)
+	l2 := d.Lng*d.Lng + d.Lat*d.Lat
+	if l2 == 0 {
+		return math.Hypot(p.Lng-s.A.Lng, p.Lat-s.A.Lat)
+	}
+	t := ((p.Lng-s.A.Lng)*d.Lng + (p.Lat-s.A.Lat)*d.Lat) / l2
+	t = math.Max(0, math.Min(1, t))
+	return math.Hypot(p.Lng-(s.A.Lng+t*d.Lng), p.Lat-(s.A.Lat+t*d.Lat))
+}
+
+// inSpan reports whether a point that is collinear with the segment lies within its end points.
+func inSpan(p geometry.Point, s Segment) bool {
+	return p.Lng >= math.Min(s.A.Lng, s.B.Lng) && p.Lng <= math.Max(s.A.Lng, s.B.Lng) &&
+		p.Lat >= math.Min(s.A.Lat, s.B.Lat) && p.Lat <= math.Max(s.A.Lat, s.B.Lat)
+}
+
+func contains(pts []geometry.Point, p geometry.Point) bool {
+	for _, v := range pts {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
+
+func sub(a geometry.Point, b geometry.Point) geometry.Point {
+	return geometry.Point{Lng: a.Lng - b.Lng, Lat: a.Lat - b.Lat}
+}
+
+func cross(a geometry.Point, b geometry.Point) float64 {
+	return a.Lng*b.Lat - a.Lat*b.Lng
+}
@@ -0,0 +1,157 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+)
+
+func square(x float64, y float64, size float64) geometry.Polygon {
+	return geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: x, Lat: y},
+		{Lng: x + size, Lat: y},
+		{Lng: x + size, Lat: y + size},
+		{Lng: x, Lat: y + size},
+		{Lng: x, Lat: y},
+	}}}}
+}
+
+func area(polys []geometry.Polygon) float64 {
+	total := 0.0
+	for _, p := range polys {
+		for _, r := range p.Coordinates {
+			total += SignedArea(r.Coordinates)
+		}
+	}
+	return total
+}
+
+func TestSignedArea(t *testing.T) {
+	sq := square(0, 0, 2)
+	assert.Equal(t, SignedArea(sq.Coordinates[0].Coordinates), 4.0)
+
+	reversed := []geometry.Point{}
+	for i := len(sq.Coordinates[0].Coordinates) - 1; i >= 0; i-- {
+		reversed = append(reversed, sq.Coordinates[0].Coordinates[i])
+	}
+	assert.Equal(t, SignedArea(reversed), -4.0)
+}
+
+func TestIntersection(t *testing.T) {
+	tests := map[string]struct {
+		s1   Segment
+		s2   Segment
+		want []geometry.Point
+	}{
+		"crossing": {
+			s1:   Segment{A: geometry.Point{Lng: 0, Lat: 0}, B: geometry.Point{Lng: 2, Lat: 2}},
+			s2:   Segment{A: geometry.Point{Lng: 0, Lat: 2}, B: geometry.Point{Lng: 2, Lat: 0}},
+			want: []geometry.Point{{Lng: 1, Lat: 1}},
+		},
+		"touching": {
+			s1:   Segment{A: geometry.Point{Lng: 0, Lat: 0}, B: geometry.Point{Lng: 2, Lat: 0}},
+			s2:   Segment{A: geometry.Point{Lng: 1, Lat: 0}, B: geometry.Point{Lng: 1, Lat: 3}},
+			want: []geometry.Point{{Lng: 1, Lat: 0}},
+		},
+		"disjoint": {
+			s1:   Segment{A: geometry.Point{Lng: 0, Lat: 0}, B: geometry.Point{Lng: 1, Lat: 0}},
+			s2:   Segment{A: geometry.Point{Lng: 0, Lat: 1}, B: geometry.Point{Lng: 1, Lat: 1}},
+			want: nil,
+		},
+		"overlapping": {
+			s1:   Segment{A: geometry.Point{Lng: 0, Lat: 0}, B: geometry.Point{Lng: 2, Lat: 0}},
+			s2:   Segment{A: geometry.Point{Lng: 1, Lat: 0}, B: geometry.Point{Lng: 3, Lat: 0}},
+			want: []geometry.Point{{Lng: 2, Lat: 0}, {Lng: 1, Lat: 0}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, Intersection(tt.s1, tt.s2), tt.want)
+		})
+	}
+}
+
+func TestUnion(t *testing.T) {
+	res := Union([]geometry.Polygon{square(0, 0, 2), square(1, 1, 2)})
+	assert.Equal(t, len(res), 1)
+	assert.Equal(t, len(res[0].Coordinates), 1)
+	assert.Equal(t, area(res), 7.0)
+
+	// disjoint squares stay separate
+	res = Union([]geometry.Polygon{square(0, 0, 1), square(5, 5, 1)})
+	assert.Equal(t, len(res), 2)
+	assert.Equal(t, area(res), 2.0)
+
+	// a ring of squares encloses a hole
+	ring := []geometry.Polygon{square(0, 0, 2), square(2, 0, 2), square(4, 0, 2), square(4, 2, 2), square(4, 4, 2), square(2, 4, 2), square(0, 4, 2), square(0, 2, 2)}
+	res = Union(ring)
+	assert.Equal(t, len(res), 1)
+	assert.Equal(t, len(res[0].Coordinates), 2)
+	assert.Equal(t, area(res), 32.0)
+}
+
+func TestOverlayBowtie(t *testing.T) {
+	bowtie := []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 2, Lat: 0}, {Lng: 0, Lat: 2}, {Lng: 0, Lat: 0}}
+	res := Node(Segments(bowtie)).Overlay(func(p geometry.Point) bool {
+		return InRing(p, bowtie)
+	})
+	assert.Equal(t, len(res), 2)
+	for _, p := range res {
+		assert.True(t, SignedArea(p.Coordinates[0].Coordinates) > 0)
+	}
+	assert.True(t, math.Abs(area(res)-2.0) < 1e-12)
+}
+
+func TestFaces(t *testing.T) {
+	outer := square(0, 0, 10)
+	inner := square(2, 2, 2)
+	segs := append(Segments(outer.Coordinates[0].Coordinates), Segments(inner.Coordinates[0].Coordinates)...)
+	// dangling line
+	segs = append(segs, Segment{A: geometry.Point{Lng: 10, Lat: 10}, B: geometry.Point{Lng: 12, Lat: 12}})
+
+	res := Node(segs).Faces()
+	assert.Equal(t, len(res), 2)
+	assert.Equal(t, area(res), 100.0)
+	holes := 0
+	for _, p := range res {
+		holes += len(p.Coordinates) - 1
+	}
+	assert.Equal(t, holes, 1)
+}
+
+func TestTriangulate(t *testing.T) {
+	pts := []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: 2}, {Lng: 1, Lat: 1}, {Lng: 1, Lat: 1}}
+	tris := Triangulate(pts)
+	assert.Equal(t, len(tris), 4)
+
+	total := 0.0
+	for _, tr := range tris {
+		a := SignedArea([]geometry.Point{pts[tr[0]], pts[tr[1]], pts[tr[2]]})
+		assert.True(t, a > 0)
+		total += a
+		// every triangle uses the center
+		assert.True(t, tr[0] == 4 || tr[1] == 4 || tr[2] == 4)
+	}
+	assert.Equal(t, total, 4.0)
+
+	assert.Equal(t, len(Triangulate(pts[:2])), 0)
+	assert.Equal(t, len(Triangulate([]geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 2, Lat: 2}})), 0)
+}
+
+func TestTriangulateGrid(t *testing.T) {
+	pts := []geometry.Point{}
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			pts = append(pts, geometry.Point{Lng: float64(x), Lat: float64(y)})
+		}
+	}
+	tris := Triangulate(pts)
+	assert.Equal(t, len(tris), 32)
+	total := 0.0
+	for _, tr := range tris {
+		total += SignedArea([]geometry.Point{pts[tr[0]], pts[tr[1]], pts[tr[2]]})
+	}
+	assert.Equal(t, math.Round(total*1e9)/1e9, 16.0)
+}
@@ -0,0 +1,167 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// triangle is a triangle of a triangulation with its circumcircle.
+type triangle struct {
+	v      [3]int
+	center geometry.Point
+	r2     float64
+}
+
+// Triangulate returns the Delaunay triangulation of the points, using the Bowyer-Watson algorithm, as the indices
+// of the points of every triangle in counter-clockwise order. Repeated points are only used once.
+func Triangulate(pts []geometry.Point) [][3]int {
+	if len(pts) < 3 {
+		return nil
+	}
+	minX, minY, maxX, maxY := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, p := range pts {
+		minX, minY = math.Min(minX, p.Lng), math.Min(minY, p.Lat)
+		maxX, maxY = math.Max(maxX, p.Lng), math.Max(maxY, p.Lat)
+	}
+	d := 1e4 * math.Max(math.Max(maxX-minX, maxY-minY), Tolerance)
+	mx, my := (minX+maxX)/2, (minY+maxY)/2
+
+	// a super triangle that contains all the points is removed at the end. Being finite, it can take the place of
+	// some triangles along the convex hull of the points, which are added back afterwards
+	n := len(pts)
+	all := append(append([]geometry.Point{}, pts...),
+		geometry.Point{Lng: mx - d, Lat: my - d},
+		geometry.Point{Lng: mx + d, Lat: my - d},
+		geometry.Point{Lng: mx, Lat: my + d},
+	)
+	tris := []triangle{circumcircle(all, [3]int{n, n + 1, n + 2})}
+
+	seen := map[geometry.Point]bool{}
+	for i, p := range pts {
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+
+		// the triangles whose circumcircle contains the point leave a cavity, whose boundary is made of
+		// the edges they don't share, that is filled with triangles fanning out from the point
+		edges := map[[2]int]int{}
+		order := [][2]int{}
+		kept := tris[:0]
+		for _, t := range tris {
+			if dx, dy := p.Lng-t.center.Lng, p.Lat-t.center.Lat; dx*dx+dy*dy >= t.r2 {
+				kept = append(kept, t)
+				continue
+			}
+			for k := 0; k < 3; k++ {
+				e := [2]int{t.v[k], t.v[(k+1)%3]}
+				key := e
+				if key[0] > key[1] {
+					key = [2]int{key[1], key[0]}
+				}
+				if edges[key] == 0 {
+					order = append(order, e)
+				}
+				edges[key]++
+			}
+		}
+		tris = kept
+		for _, e := range order {
+			key := e
+			if key[0] > key[1] {
+				key = [2]int{key[1], key[0]}
+			}
+			if edges[key] == 1 {
+				tris = append(tris, circumcircle(all, [3]int{e[0], e[1], i}))
+			}
+		}
+	}
+
+	res := [][3]int{}
+	for _, t := range tris {
+		if t.v[0] >= n || t.v[1] >= n || t.v[2] >= n {
+			continue
+		}
+		a, b, c := all[t.v[0]], all[t.v[1]], all[t.v[2]]
+		area := cross(sub(b, a), sub(c, a))
+		switch {
+		case math.Abs(area) < Tolerance*Tolerance:
+			continue
+		case area < 0:
+			res = append(res, [3]int{t.v[0], t.v[2], t.v[1]})
+		default:
+			res = append(res, t.v)
+		}
+	}
+	return fillHull(pts, res)
+}
+
+// fillHull adds the triangles that are missing between the boundary of a triangulation and the convex hull of its
+// points, filling every concave corner of the boundary until it is convex.
+func fillHull(pts []geometry.Point, tris [][3]int) [][3]int {
+	edges := map[[2]int]bool{}
+	for _, t := range tris {
+		for k := 0; k < 3; k++ {
+			edges[[2]int{t[k], t[(k+1)%3]}] = true
+		}
+	}
+	// the boundary runs counter-clockwise along the edges that only one triangle has
+	next, prev := map[int]int{}, map[int]int{}
+	for e := range edges {
+		if edges[[2]int{e[1], e[0]}] {
+			continue
+		}
+		if _, ok := next[e[0]]; ok {
+			// the boundary touches itself
+			return tris
+		}
+		next[e[0]], prev[e[1]] = e[1], e[0]
+	}
+
+	for changed := true; changed && len(next) > 3; {
+		changed = false
+		for b := range next {
+			a, c := prev[b], next[b]
+			if cross(sub(pts[b], pts[a]), sub(pts[c], pts[b])) >= 0 {
+				continue
+			}
+			empty := true
+			for v := range next {
+				if v != a && v != b && v != c && inTriangle(pts[v], pts[a], pts[c], pts[b]) {
+					empty = false
+					break
+				}
+			}
+			if !empty {
+				continue
+			}
+			tris = append(tris, [3]int{a, c, b})
+			next[a], prev[c] = c, a
+			delete(next, b)
+			delete(prev, b)
+			changed = true
+		}
+	}
+	return tris
+}
+
+// inTriangle reports whether the point lies inside or on the counter-clockwise triangle.
+func inTriangle(p geometry.Point, a geometry.Point, b geometry.Point, c geometry.Point) bool {
+	return cross(sub(b, a), sub(p, a)) >= 0 && cross(sub(c, b), sub(p, b)) >= 0 && cross(sub(a, c), sub(p, c)) >= 0
+}
+
+// circumcircle returns the triangle of the points with the given indices and its circumcircle.
+// Collinear points have an infinite circumcircle.
+func circumcircle(pts []geometry.Point, v [3]int) triangle {
+	a, b, c := pts[v[0]], pts[v[1]], pts[v[2]]
+	bx, by := b.Lng-a.Lng, b.Lat-a.Lat
+	cx, cy := c.Lng-a.Lng, c.Lat-a.Lat
+	d := 2 * (bx*cy - by*cx)
+	if d == 0 {
+		return triangle{v: v, center: a, r2: math.Inf(1)}
+	}
+	ux := (cy*(bx*bx+by*by) - by*(cx*cx+cy*cy)) / d
+	uy := (bx*(cx*cx+cy*cy) - cx*(bx*bx+by*by)) / d
+	return triangle{v: v, center: geometry.Point{Lng: a.Lng + ux, Lat: a.Lat + uy}, r2: ux*ux + uy*uy}
+}
@@ -0,0 +1,347 @@
+package interpolation
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// ContourOptions ...
+type ContourOptions struct {
+	// ZProperty holds the value of the points and receives the break values of the contours. "elevation" is the default value
+	ZProperty *string
+	// BreaksProperties are copied to the contour of the break, or the band, with the same index. nil is the default value
+	BreaksProperties []map[string]interface{}
+}
+
+// vertex is a position of the grid, or the center of a cell, with its value.
+type vertex struct {
+	p geometry.Point
+	v float64
+}
+
+// Isolines draws the contour lines at the given breaks of a regular grid of Point features, using marching squares.
+// Every break returns a MultiLineString feature, which is empty if the values never cross the break, or a LineString
+// feature if they cross it along a single line, with the break value in the ZProperty. The values are read from the ZProperty of the points, or their altitude if they don't have it.
+// Cells whose corners are alternately above and below a break are split in four triangles around their center,
+// whose value is the mean of the corners.
+//
+// Examples:
+//
+//	fc, err := interpolation.Isolines(grid, []float64{10, 20, 30}, interpolation.ContourOptions{ZProperty: common.StringPtr("temperature")})
+func Isolines(points *feature.Collection, breaks []float64, options ContourOptions) (*feature.Collection, error) {
+	if options.ZProperty == nil {
+		options.ZProperty = common.StringPtr("elevation")
+	}
+	cells, err := gridCells(points, *options.ZProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	features := []feature.Feature{}
+	for i, b := range breaks {
+		segments := [][2]geometry.Point{}
+		for _, c := range cells {
+			for _, piece := range split(c, b) {
+				if s, ok := isolineSegment(piece, b); ok {
+					segments = append(segments, s)
+				}
+			}
+		}
+		lines := joinSegments(segments)
+
+		geom := geometry.Geometry{GeoJSONType: geojson.MultiLineString, Coordinates: common.MultiLineStringCoords(lines)}
+		if len(lines) == 1 {
+			geom = geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: common.LineStringCoords(lines[0].Coordinates)}
+		}
+		f, err := feature.New(geom, nil, contourProperties(options, i, map[string]interface{}{*options.ZProperty: b}), "")
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// Isobands fills the areas between consecutive breaks of a regular grid of Point features, using marching squares.
+// Every band includes its lower break and excludes its upper one, and returns a MultiPolygon feature, which is empty
+// if no value falls within the band, with the breaks in the ZProperty followed by "_min" and "_max".
+// Nested areas are returned as holes of the surrounding polygons.
+// The values are read from the ZProperty of the points, or their altitude if they don't have it.
+//
+// Examples:
+//
+//	fc, err := interpolation.Isobands(grid, []float64{0, 10, 20}, interpolation.ContourOptions{})
+func Isobands(points *feature.Collection, breaks []float64, options ContourOptions) (*feature.Collection, error) {
+	if options.ZProperty == nil {
+		options.ZProperty = common.StringPtr("elevation")
+	}
+	if len(breaks) < 2 {
+		return nil, errors.New("at least two breaks are required")
+	}
+	cells, err := gridCells(points, *options.ZProperty)
+	if err != nil {
+		return nil, err
+	}
+
+	features := []feature.Feature{}
+	for i := 0; i < len(breaks)-1; i++ {
+		lo, hi := breaks[i], breaks[i+1]
+		if lo >= hi {
+			return nil, errors.New("breaks must be in ascending order")
+		}
+
+		pieces := []geometry.Polygon{}
+		for _, c := range cells {
+			for _, piece := range split(c, lo, hi) {
+				ring := bandRing(piece, lo, hi)
+				if len(ring) < 4 || math.Abs(planar.SignedArea(ring)) < planar.Tolerance*planar.Tolerance {
+					continue
+				}
+				pieces = append(pieces, geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: ring}}})
+			}
+		}
+		polys := []geometry.Polygon{}
+		if len(pieces) > 0 {
+			polys = planar.Union(pieces)
+		}
+
+		properties := map[string]interface{}{*options.ZProperty + "_min": lo, *options.ZProperty + "_max": hi}
+		geom := geometry.Geometry{GeoJSONType: geojson.MultiPolygon, Coordinates: common.MultiPolygonCoords(polys)}
+		f, err := feature.New(geom, nil, contourProperties(options, i, properties), "")
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// gridCells arranges the points in rows of equal latitude and columns of equal longitude and returns the
+// corners of every cell of the grid, counter-clockwise from the south west one.
+func gridCells(points *feature.Collection, property string) ([][]vertex, error) {
+	if points == nil || len(points.Features) == 0 {
+		return nil, errors.New("at least one point is required")
+	}
+
+	vertices := make([]vertex, len(points.Features))
+	lngs, lats := []float64{}, []float64{}
+	for i := range points.Features {
+		p, v, err := pointValue(&points.Features[i], property)
+		if err != nil {
+			return nil, err
+		}
+		vertices[i] = vertex{p: *p, v: v}
+		lngs = append(lngs, p.Lng)
+		lats = append(lats, p.Lat)
+	}
+	lngs, lats = distinct(lngs), distinct(lats)
+	if len(lngs)*len(lats) != len(vertices) {
+		return nil, errors.New("points must form a regular grid")
+	}
+
+	grid := make([][]*vertex, len(lats))
+	for r := range grid {
+		grid[r] = make([]*vertex, len(lngs))
+	}
+	for i := range vertices {
+		r, c := search(lats, vertices[i].p.Lat), search(lngs, vertices[i].p.Lng)
+		if grid[r][c] != nil {
+			return nil, errors.New("points must form a regular grid")
+		}
+		grid[r][c] = &vertices[i]
+	}
+
+	cells := [][]vertex{}
+	for r := 0; r < len(lats)-1; r++ {
+		for c := 0; c < len(lngs)-1; c++ {
+			cells = append(cells, []vertex{*grid[r][c], *grid[r][c+1], *grid[r+1][c+1], *grid[r+1][c]})
+		}
+	}
+	return cells, nil
+}
+
+// distinct returns the sorted values without the ones closer than planar.Tolerance to the previous one.
+func distinct(values []float64) []float64 {
+	sort.Float64s(values)
+	res := []float64{}
+	for _, v := range values {
+		if len(res) == 0 || v-res[len(res)-1] > planar.Tolerance {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// search returns the index of the sorted distinct value closest to v.
+func search(values []float64, v float64) int {
+	i := sort.SearchFloat64s(values, v-planar.Tolerance)
+	if i == len(values) {
+		return i - 1
+	}
+	return i
+}
+
+// split returns the cell itself, or the four triangles around its center if it is a saddle for any of the levels.
+func split(cell []vertex, levels ...float64) [][]vertex {
+	saddle := false
+	for _, l := range levels {
+		a := [4]bool{}
+		for i := range a {
+			a[i] = cell[i].v >= l
+		}
+		if a[0] == a[2] && a[1] == a[3] && a[0] != a[1] {
+			saddle = true
+		}
+	}
+	if !saddle {
+		return [][]vertex{cell}
+	}
+
+	center := vertex{}
+	for _, c := range cell {
+		center.p.Lng += c.p.Lng / 4
+		center.p.Lat += c.p.Lat / 4
+		center.v += c.v / 4
+	}
+	triangles := [][]vertex{}
+	for i := range cell {
+		triangles = append(triangles, []vertex{cell[i], cell[(i+1)%4], center})
+	}
+	return triangles
+}
+
+// crossing returns the position where the value of the edge reaches the level, interpolating linearly.
+// The edge is always measured from the same end so that neighbouring cells share the exact position.
+func crossing(a vertex, b vertex, level float64) geometry.Point {
+	if b.p.Lng < a.p.Lng || (b.p.Lng == a.p.Lng && b.p.Lat < a.p.Lat) {
+		a, b = b, a
+	}
+	t := (level - a.v) / (b.v - a.v)
+	return geometry.Point{Lng: a.p.Lng + t*(b.p.Lng-a.p.Lng), Lat: a.p.Lat + t*(b.p.Lat-a.p.Lat)}
+}
+
+// isolineSegment returns the segment of the level line across a cell or triangle that is not a saddle.
+func isolineSegment(piece []vertex, level float64) ([2]geometry.Point, bool) {
+	pts := []geometry.Point{}
+	for i := range piece {
+		a, b := piece[i], piece[(i+1)%len(piece)]
+		if (a.v >= level) != (b.v >= level) {
+			pts = append(pts, crossing(a, b, level))
+		}
+	}
+	if len(pts) != 2 || pts[0] == pts[1] {
+		return [2]geometry.Point{}, false
+	}
+	return [2]geometry.Point{pts[0], pts[1]}, true
+}
+
+// bandRing walks the boundary of a cell or triangle that is not a saddle and returns the closed ring of the area
+// with values from lo up to hi, made of the corners within the band and the positions where the edges cross the breaks.
+func bandRing(piece []vertex, lo float64, hi float64) []geometry.Point {
+	ring := []geometry.Point{}
+	add := func(p geometry.Point) {
+		if len(ring) == 0 || ring[len(ring)-1] != p {
+			ring = append(ring, p)
+		}
+	}
+
+	for i := range piece {
+		a, b := piece[i], piece[(i+1)%len(piece)]
+		if a.v >= lo && a.v < hi {
+			add(a.p)
+		}
+		type cross struct {
+			t float64
+			p geometry.Point
+		}
+		crossings := []cross{}
+		for _, l := range []float64{lo, hi} {
+			if (a.v >= l) != (b.v >= l) {
+				crossings = append(crossings, cross{t: (l - a.v) / (b.v - a.v), p: crossing(a, b, l)})
+			}
+		}
+		sort.Slice(crossings, func(i, j int) bool {
+			return crossings[i].t < crossings[j].t
+		})
+		for _, c := range crossings {
+			add(c.p)
+		}
+	}
+
+	if len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+		ring = ring[:len(ring)-1]
+	}
+	if len(ring) < 3 {
+		return nil
+	}
+	return append(ring, ring[0])
+}
+
+// joinSegments links the segments that share an end into lines. Lines that come back to their start are closed.
+func joinSegments(segments [][2]geometry.Point) []geometry.LineString {
+	ends := map[geometry.Point][]int{}
+	for i, s := range segments {
+		ends[s[0]] = append(ends[s[0]], i)
+		ends[s[1]] = append(ends[s[1]], i)
+	}
+	used := make([]bool, len(segments))
+
+	follow := func(start int, from geometry.Point) []geometry.Point {
+		line := []geometry.Point{from}
+		for i, p := start, from; i >= 0; {
+			used[i] = true
+			next := segments[i][0]
+			if next == p {
+				next = segments[i][1]
+			}
+			line = append(line, next)
+			p, i = next, -1
+			for _, j := range ends[p] {
+				if !used[j] {
+					i = j
+					break
+				}
+			}
+		}
+		return line
+	}
+
+	lines := []geometry.LineString{}
+	// open lines start at an end that no other segment shares
+	for i, s := range segments {
+		if used[i] {
+			continue
+		}
+		for _, p := range s {
+			if len(ends[p]) == 1 {
+				lines = append(lines, geometry.LineString{Coordinates: follow(i, p)})
+				break
+			}
+		}
+	}
+	for i, s := range segments {
+		if !used[i] {
+			lines = append(lines, geometry.LineString{Coordinates: follow(i, s[0])})
+		}
+	}
+	return lines
+}
+
+// contourProperties adds the properties of the break with the given index to the properties of a contour.
+func contourProperties(options ContourOptions, index int, properties map[string]interface{}) map[string]interface{} {
+	if index < len(options.BreaksProperties) {
+		for k, v := range options.BreaksProperties[index] {
+			if _, ok := properties[k]; !ok {
+				properties[k] = v
+			}
+		}
+	}
+	return properties
+}
@@ -0,0 +1,125 @@
+package interpolation
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// cone returns a grid of points whose elevation is the distance from the center of the grid.
+func cone(t *testing.T) *feature.Collection {
+	features := []string{}
+	for x := 0; x <= 10; x++ {
+		for y := 0; y <= 10; y++ {
+			v := math.Hypot(float64(x-5), float64(y-5))
+			features = append(features, fmt.Sprintf("{ \"type\": \"Feature\", \"properties\": { \"elevation\": %v }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [%v, %v] } }", v, x, y))
+		}
+	}
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" + strings.Join(features, ",") + "] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	return fc
+}
+
+func TestIsolines(t *testing.T) {
+	fc, err := Isolines(cone(t), []float64{3, 20}, ContourOptions{BreaksProperties: []map[string]interface{}{{"stroke": "red"}}})
+	if err != nil {
+		t.Errorf("Isolines error %v", err)
+	}
+	assert.Equal(t, len(fc.Features), 2)
+	assert.Equal(t, fc.Features[0].Properties, map[string]interface{}{"elevation": 3.0, "stroke": "red"})
+	assert.Equal(t, fc.Features[0].Geometry.GeoJSONType, geojson.LineString)
+
+	// the single contour is a LineString that can be read back
+	ln, err := fc.Features[0].ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+	}
+	ring := ln.Coordinates
+	assert.Equal(t, ring[0], ring[len(ring)-1])
+	for _, p := range ring {
+		d := math.Hypot(p.Lng-5, p.Lat-5)
+		assert.True(t, d > 2.8 && d <= 3+1e-9)
+	}
+
+	// the break above all the values has no lines
+	assert.Equal(t, fc.Features[1].Properties, map[string]interface{}{"elevation": 20.0})
+	assert.Equal(t, len(fc.Features[1].Geometry.Coordinates.([][][]float64)), 0)
+}
+
+func TestIsolinesSaddle(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0, 0, 1] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Point\", \"coordinates\": [1, 0, 0] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Point\", \"coordinates\": [1, 1, 1] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0, 1, 0] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	res, err := Isolines(fc, []float64{0.4}, ContourOptions{})
+	if err != nil {
+		t.Errorf("Isolines error %v", err)
+	}
+	ml, err := res.Features[0].ToMultiLineString()
+	if err != nil {
+		t.Errorf("ToMultiLineString error %v", err)
+	}
+	// the center is above the break, so the lines cut off the low corners
+	assert.Equal(t, len(ml.Coordinates), 2)
+	for _, l := range ml.Coordinates {
+		assert.Equal(t, len(l.Coordinates), 3)
+	}
+}
+
+func TestIsobands(t *testing.T) {
+	fc, err := Isobands(cone(t), []float64{0, 2, 4}, ContourOptions{})
+	if err != nil {
+		t.Errorf("Isobands error %v", err)
+	}
+	assert.Equal(t, len(fc.Features), 2)
+	assert.Equal(t, fc.Features[1].Properties, map[string]interface{}{"elevation_min": 2.0, "elevation_max": 4.0})
+
+	tests := map[string]struct {
+		index int
+		holes int
+		area  float64
+	}{
+		"disc":    {index: 0, holes: 0, area: math.Pi * 4},
+		"annulus": {index: 1, holes: 1, area: math.Pi * 12},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mp, err := fc.Features[tt.index].ToMultiPolygon()
+			if err != nil {
+				t.Errorf("ToMultiPolygon error %v", err)
+				return
+			}
+			assert.Equal(t, len(mp.Coordinates), 1)
+			p := mp.Coordinates[0]
+			assert.Equal(t, len(p.Coordinates), tt.holes+1)
+			area := 0.0
+			for _, r := range p.Coordinates {
+				area += planar.SignedArea(r.Coordinates)
+			}
+			// the linear interpolation on a coarse grid cuts the curves short
+			assert.True(t, math.Abs(area-tt.area) < 0.1*tt.area)
+		})
+	}
+}
+
+func TestContourInvalid(t *testing.T) {
+	fc := cone(t)
+	fc.Features = fc.Features[1:]
+	_, err := Isolines(fc, []float64{1}, ContourOptions{})
+	assert.Equal(t, err.Error(), "points must form a regular grid")
+	_, err = Isobands(cone(t), []float64{2, 1}, ContourOptions{})
+	assert.Equal(t, err.Error(), "breaks must be in ascending order")
+}
@@ -0,0 +1,171 @@
+package interpolation
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/grids"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+const (
+	// GridPoint interpolates the values at the points of a grids.PointGrid.
+	GridPoint = "point"
+	// GridSquare interpolates the values at the centers of the cells of a grids.SquareGrid.
+	GridSquare = "square"
+	// GridHex interpolates the values at the centers of the cells of a grids.HexGrid.
+	GridHex = "hex"
+	// GridTriangle interpolates the values at the centers of the cells of a grids.TriangleGrid.
+	GridTriangle = "triangle"
+)
+
+// InterpolateOptions ...
+type InterpolateOptions struct {
+	// GridType is the kind of grid the values are interpolated onto. GridSquare is the default value
+	GridType string
+	// Property holds the value of the points and receives the interpolated value of the cells. "elevation" is the default value
+	Property *string
+	// Units of the cell size and the distances. constants.UnitKilometers is the default value
+	Units string
+	// Weight is the power the distances are raised to, higher values favour the nearest points. 1 is the default value
+	Weight *float64
+}
+
+// Interpolate estimates the values of the Point features on a grid over their bbox using Inverse Distance Weighting.
+// Every cell gets the average of the point values weighted by the inverse of the distance from its center raised to
+// the Weight power, or the value of a point that lies exactly at its center. The values are read from the Property of
+// the points, or their altitude if they don't have it.
+//
+// Examples:
+//
+//	fc, err := interpolation.Interpolate(sensors, 10, interpolation.InterpolateOptions{GridType: interpolation.GridHex, Property: common.StringPtr("pm10")})
+func Interpolate(points *feature.Collection, cellSize float64, options InterpolateOptions) (*feature.Collection, error) {
+	if options.GridType == "" {
+		options.GridType = GridSquare
+	}
+	if options.Property == nil {
+		options.Property = common.StringPtr("elevation")
+	}
+	if options.Units == "" {
+		options.Units = constants.UnitKilometers
+	}
+	if options.Weight == nil {
+		options.Weight = common.Float64Ptr(1)
+	}
+	if points == nil || len(points.Features) == 0 {
+		return nil, errors.New("at least one point is required")
+	}
+
+	pts := make([]geometry.Point, len(points.Features))
+	values := make([]float64, len(points.Features))
+	for i := range points.Features {
+		p, v, err := pointValue(&points.Features[i], *options.Property)
+		if err != nil {
+			return nil, err
+		}
+		pts[i], values[i] = *p, v
+	}
+
+	b, err := measurement.BBox(points)
+	if err != nil {
+		return nil, err
+	}
+	bbox, err := measurement.ToBBOX(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var grid *feature.Collection
+	gridOptions := grids.GridOptions{Units: options.Units}
+	switch options.GridType {
+	case GridPoint:
+		grid, err = grids.PointGrid(*bbox, cellSize, gridOptions)
+	case GridSquare:
+		grid, err = grids.SquareGrid(*bbox, cellSize, gridOptions)
+	case GridHex:
+		grid, err = grids.HexGrid(*bbox, cellSize, gridOptions)
+	case GridTriangle:
+		grid, err = grids.TriangleGrid(*bbox, cellSize, gridOptions)
+	default:
+		return nil, errors.New("invalid grid type")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range grid.Features {
+		c, err := cellCenter(&grid.Features[i])
+		if err != nil {
+			return nil, err
+		}
+
+		zw, sw := 0.0, 0.0
+		exact := false
+		for j, p := range pts {
+			d, err := measurement.Distance(c.Lng, c.Lat, p.Lng, p.Lat, options.Units)
+			if err != nil {
+				return nil, err
+			}
+			if d == 0 {
+				zw, sw, exact = values[j], 1, true
+				break
+			}
+			w := 1 / math.Pow(d, *options.Weight)
+			zw += w * values[j]
+			sw += w
+		}
+		if !exact && sw == 0 {
+			return nil, errors.New("cannot weight the values")
+		}
+		grid.Features[i].Properties = map[string]interface{}{*options.Property: zw / sw}
+	}
+	return grid, nil
+}
+
+// pointValue returns the position of a Point feature and the numeric value of its property or its altitude.
+func pointValue(f *feature.Feature, property string) (*geometry.Point, float64, error) {
+	if f.Geometry.GeoJSONType != geojson.Point {
+		return nil, 0, errors.New("points must be Point features")
+	}
+	p, err := f.ToPoint()
+	if err != nil {
+		return nil, 0, err
+	}
+	if v, ok := common.ToFloat(f.Properties[property]); ok {
+		return p, v, nil
+	}
+
+	pos, err := common.RawPosition(f.Geometry.Coordinates)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(pos) < 3 {
+		return nil, 0, errors.New("point must have a numeric " + property + " property or an altitude")
+	}
+	return p, pos[2], nil
+}
+
+// cellCenter returns the point of a point cell or the mean of the vertices of a polygon cell.
+func cellCenter(f *feature.Feature) (*geometry.Point, error) {
+	if f.Geometry.GeoJSONType == geojson.Point {
+		return f.ToPoint()
+	}
+	p, err := f.ToPolygon()
+	if err != nil {
+		return nil, err
+	}
+	ring := p.Coordinates[0].Coordinates
+	c := geometry.Point{}
+	for _, v := range ring[:len(ring)-1] {
+		c.Lng += v.Lng
+		c.Lat += v.Lat
+	}
+	c.Lng /= float64(len(ring) - 1)
+	c.Lat /= float64(len(ring) - 1)
+	return &c, nil
+}
@@ -0,0 +1,87 @@
+package interpolation
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+func sensors(t *testing.T) *feature.Collection {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"pm10\": 10 }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0, 0] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"pm10\": 30 }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [1, 1] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Point\", \"coordinates\": [1, 0, 20] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	return fc
+}
+
+func TestInterpolate(t *testing.T) {
+	side, err := measurement.Distance(0, 0, 0.5, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+
+	for _, gridType := range []string{GridPoint, GridSquare, GridHex, GridTriangle} {
+		t.Run(gridType, func(t *testing.T) {
+			fc, err := Interpolate(sensors(t), side/4, InterpolateOptions{GridType: gridType, Property: common.StringPtr("pm10")})
+			if err != nil {
+				t.Errorf("Interpolate error %v", err)
+				return
+			}
+			assert.True(t, len(fc.Features) > 4)
+			for _, f := range fc.Features {
+				v := f.Properties["pm10"].(float64)
+				assert.True(t, v >= 10 && v <= 30)
+			}
+		})
+	}
+}
+
+func TestInterpolateValues(t *testing.T) {
+	side, err := measurement.Distance(0, 0, 0.5, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+	fc, err := Interpolate(sensors(t), side, InterpolateOptions{GridType: GridPoint, Property: common.StringPtr("pm10"), Weight: common.Float64Ptr(2)})
+	if err != nil {
+		t.Errorf("Interpolate error %v", err)
+	}
+	// the grid points at the sensors take their values
+	assert.Equal(t, fc.Features[0].Properties["pm10"], 10.0)
+	assert.Equal(t, fc.Features[6].Properties["pm10"], 20.0)
+	assert.Equal(t, fc.Features[8].Properties["pm10"], 30.0)
+	// the center is equally far from the sensors at the corners
+	v := fc.Features[4].Properties["pm10"].(float64)
+	assert.True(t, v > 19.99 && v < 20.01)
+}
+
+func TestInterpolateIntValues(t *testing.T) {
+	side, err := measurement.Distance(0, 0, 0.5, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+	// values set in Go rather than decoded from JSON can be integers
+	fc := sensors(t)
+	fc.Features[0].Properties["pm10"] = 10
+	fc.Features[1].Properties["pm10"] = int64(30)
+	res, err := Interpolate(fc, side, InterpolateOptions{GridType: GridPoint, Property: common.StringPtr("pm10")})
+	if err != nil {
+		t.Errorf("Interpolate error %v", err)
+	}
+	assert.Equal(t, res.Features[0].Properties["pm10"], 10.0)
+	assert.Equal(t, res.Features[8].Properties["pm10"], 30.0)
+}
+
+func TestInterpolateInvalid(t *testing.T) {
+	_, err := Interpolate(sensors(t), 10, InterpolateOptions{})
+	assert.Equal(t, err.Error(), "point must have a numeric elevation property or an altitude")
+	_, err = Interpolate(sensors(t), 10, InterpolateOptions{GridType: "circle", Property: common.StringPtr("pm10")})
+	assert.Equal(t, err.Error(), "invalid grid type")
+}
@@ -47,11 +47,15 @@ func PointInMultiPolygon(p geometry.Point, mp geometry.MultiPolygon) bool {
 }
 
 // InBBOX returns true if the point is within the Bounding Box
+// A Bounding Box with West greater than East wraps around the 180th meridian.
 func InBBOX(pt geometry.Point, bbox geojson.BBOX) bool {
-	return bbox.West <= pt.Lng &&
-		bbox.South <= pt.Lat &&
-		bbox.East >= pt.Lng &&
-		bbox.North >= pt.Lat
+	if bbox.South > pt.Lat || bbox.North < pt.Lat {
+		return false
+	}
+	if bbox.West > bbox.East {
+		return bbox.West <= pt.Lng || bbox.East >= pt.Lng
+	}
+	return bbox.West <= pt.Lng && bbox.East >= pt.Lng
 }
 
 func inRing(pt geometry.Point, ring []geometry.Point) bool {
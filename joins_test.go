@@ -335,3 +335,49 @@ func TestMultiPolyWithHole(t *testing.T) {
 		}
 	}
 }
+
+func TestInBBOX(t *testing.T) {
+	tests := map[string]struct {
+		point geometry.Point
+		bbox  geojson.BBOX
+		want  bool
+	}{
+		"inside": {
+			point: geometry.Point{Lng: 5, Lat: 5},
+			bbox:  geojson.BBOX{West: 0, South: 0, East: 10, North: 10},
+			want:  true,
+		},
+		"outside": {
+			point: geometry.Point{Lng: 15, Lat: 5},
+			bbox:  geojson.BBOX{West: 0, South: 0, East: 10, North: 10},
+			want:  false,
+		},
+		"inside wrapped east": {
+			point: geometry.Point{Lng: -175, Lat: 5},
+			bbox:  geojson.BBOX{West: 170, South: 0, East: -170, North: 10},
+			want:  true,
+		},
+		"inside wrapped west": {
+			point: geometry.Point{Lng: 175, Lat: 5},
+			bbox:  geojson.BBOX{West: 170, South: 0, East: -170, North: 10},
+			want:  true,
+		},
+		"outside wrapped": {
+			point: geometry.Point{Lng: 0, Lat: 5},
+			bbox:  geojson.BBOX{West: 170, South: 0, East: -170, North: 10},
+			want:  false,
+		},
+		"outside wrapped latitude": {
+			point: geometry.Point{Lng: 175, Lat: 15},
+			bbox:  geojson.BBOX{West: 170, South: 0, East: -170, North: 10},
+			want:  false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := InBBOX(tt.point, tt.bbox); got != tt.want {
+				t.Errorf("InBBOX() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
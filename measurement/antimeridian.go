@@ -0,0 +1,170 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+// AntimeridianBBox takes a set of features and returns the minimal bounding box of all input features
+// as [west, south, east, north]. Unlike BBox, longitudes are treated as a circle: when the features are
+// smaller across the 180th meridian the box wraps around it and West is greater than East, as described in
+// https://tools.ietf.org/html/rfc7946#section-5.2
+// Segments longer than 180 degrees of longitude are taken to cross the antimeridian.
+// The Envelope of the features can be created with BBoxPolygon.
+func AntimeridianBBox(t interface{}) ([]float64, error) {
+	parts, err := positionParts(t)
+	if err != nil {
+		return nil, err
+	}
+
+	south, north := math.Inf(1), math.Inf(-1)
+	// the arcs of longitude covered by the positions and segments, starting in [-180, 180)
+	arcs := [][2]float64{}
+	for _, pts := range parts {
+		for i, p := range pts {
+			south = math.Min(south, p.Lat)
+			north = math.Max(north, p.Lat)
+			lng := common.NormalizeLng(p.Lng)
+			arcs = append(arcs, [2]float64{lng, lng})
+			if i == 0 {
+				continue
+			}
+			prev := common.NormalizeLng(pts[i-1].Lng)
+			d := lng - prev
+			if d > 180 {
+				d -= 360
+			} else if d < -180 {
+				d += 360
+			}
+			if d < 0 {
+				arcs = append(arcs, [2]float64{lng, lng - d})
+			} else {
+				arcs = append(arcs, [2]float64{prev, prev + d})
+			}
+		}
+	}
+	if len(arcs) == 0 {
+		return nil, errors.New("no coordinates found")
+	}
+
+	west, east := coveringArc(arcs)
+	return []float64{west, south, east, north}, nil
+}
+
+// coveringArc returns the west and east longitudes of the smallest arc that covers all the arcs,
+// which is the complement of the largest gap between them. The arcs start in [-180, 180).
+func coveringArc(arcs [][2]float64) (float64, float64) {
+	sort.Slice(arcs, func(i, j int) bool {
+		return arcs[i][0] < arcs[j][0]
+	})
+
+	west, east := arcs[0][0], arcs[0][1]
+	end := arcs[0][1]
+	gap := -1.0
+	for _, a := range arcs[1:] {
+		if a[0]-end > gap {
+			gap = a[0] - end
+			west, east = a[0], end
+		}
+		end = math.Max(end, a[1])
+	}
+	if arcs[0][0]+360-end >= gap {
+		gap = arcs[0][0] + 360 - end
+		west, east = arcs[0][0], end
+	}
+	if gap <= 0 {
+		// the arcs cover the whole circle
+		return -180, 180
+	}
+	if east > 180 {
+		east -= 360
+	} else if east == -180 {
+		east = 180
+	}
+	return west, east
+}
+
+// positionParts returns the positions of every point, line and ring of t, so that consecutive positions form segments.
+func positionParts(t interface{}) ([][]geometry.Point, error) {
+	parts := [][]geometry.Point{}
+	switch gtp := t.(type) {
+	case *feature.Collection:
+		for i := range gtp.Features {
+			p, err := positionParts(&gtp.Features[i].Geometry)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, p...)
+		}
+	case *feature.Feature:
+		return positionParts(&gtp.Geometry)
+	case *geometry.Collection:
+		for i := range gtp.Geometries {
+			p, err := positionParts(&gtp.Geometries[i])
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, p...)
+		}
+	case *geometry.Geometry:
+		switch gtp.GeoJSONType {
+		case geojson.Point:
+			p, err := gtp.ToPoint()
+			if err != nil {
+				return nil, err
+			}
+			return positionParts(p)
+		case geojson.MultiPoint:
+			mp, err := gtp.ToMultiPoint()
+			if err != nil {
+				return nil, err
+			}
+			return positionParts(mp)
+		case geojson.LineString, geojson.MultiLineString:
+			lines, err := common.Lines(gtp)
+			if err != nil {
+				return nil, err
+			}
+			for _, l := range lines {
+				parts = append(parts, l.Coordinates)
+			}
+		case geojson.Polygon, geojson.MultiPolygon:
+			polys, err := common.RawPolygons(gtp)
+			if err != nil {
+				return nil, err
+			}
+			return positionParts(&geometry.MultiPolygon{Coordinates: polys})
+		}
+	case *geometry.Point:
+		parts = append(parts, []geometry.Point{*gtp})
+	case *geometry.MultiPoint:
+		for _, p := range gtp.Coordinates {
+			parts = append(parts, []geometry.Point{p})
+		}
+	case *geometry.LineString:
+		parts = append(parts, gtp.Coordinates)
+	case *geometry.MultiLineString:
+		for _, l := range gtp.Coordinates {
+			parts = append(parts, l.Coordinates)
+		}
+	case *geometry.Polygon:
+		for _, r := range gtp.Coordinates {
+			parts = append(parts, r.Coordinates)
+		}
+	case *geometry.MultiPolygon:
+		for _, p := range gtp.Coordinates {
+			for _, r := range p.Coordinates {
+				parts = append(parts, r.Coordinates)
+			}
+		}
+	default:
+		return nil, errors.New("unsupported geojson type")
+	}
+	return parts, nil
+}
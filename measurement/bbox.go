@@ -0,0 +1,190 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/conversions"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+// ToBBOX converts a bbox given as [west, south, east, north] or [west, south, minAltitude, east, north, maxAltitude]
+// to a geojson.BBOX.
+func ToBBOX(bbox []float64) (*geojson.BBOX, error) {
+	switch len(bbox) {
+	case 4:
+		return geojson.NewBBox(bbox[0], bbox[1], bbox[2], bbox[3]), nil
+	case 6:
+		return geojson.NewBBox(bbox[0], bbox[1], bbox[3], bbox[4]), nil
+	}
+	return nil, errors.New("bbox must have 4 or 6 values")
+}
+
+// BBoxExpand pads the bbox by the given distance on every side. The padding is computed with Destination
+// at the latitude of the bbox closest to a pole, so that it is at least the given distance everywhere along the edges.
+// A bbox expanded over a pole covers all longitudes. A bbox with West greater than East wraps around the 180th meridian.
+func BBoxExpand(bbox geojson.BBOX, distance float64, units string) (*geojson.BBOX, error) {
+	radians, err := conversions.LengthToRadians(distance, units)
+	if err != nil {
+		return nil, err
+	}
+	south, err := Destination(geometry.Point{Lng: bbox.West, Lat: bbox.South}, distance, 180, units)
+	if err != nil {
+		return nil, err
+	}
+	north, err := Destination(geometry.Point{Lng: bbox.West, Lat: bbox.North}, distance, 0, units)
+	if err != nil {
+		return nil, err
+	}
+
+	res := geojson.BBOX{South: south.Lat, North: north.Lat}
+	// a destination past a pole comes back on the other side of the globe
+	degrees := conversions.RadiansToDegrees(radians)
+	if bbox.South-degrees <= -90 {
+		res.South = -90
+	}
+	if bbox.North+degrees >= 90 {
+		res.North = 90
+	}
+	if res.South == -90 || res.North == 90 {
+		res.West, res.East = -180, 180
+		return &res, nil
+	}
+
+	lat := res.North
+	if math.Abs(res.South) > math.Abs(res.North) {
+		lat = res.South
+	}
+	west, err := Destination(geometry.Point{Lng: 0, Lat: lat}, distance, 270, units)
+	if err != nil {
+		return nil, err
+	}
+	pad := -west.Lng
+
+	width := bboxWidth(bbox) + 2*pad
+	if width >= 360 {
+		res.West, res.East = -180, 180
+		return &res, nil
+	}
+	res.West = common.NormalizeLng(bbox.West - pad)
+	res.East = res.West + width
+	if res.East > 180 {
+		res.East -= 360
+	}
+	return &res, nil
+}
+
+// BBoxSquare takes a bbox and returns the smallest square bbox that contains it.
+// The sides are compared by their distance, the shorter one is extended around its midpoint.
+// The latitudes are clamped to [-90, 90].
+func BBoxSquare(bbox geojson.BBOX) (*geojson.BBOX, error) {
+	horizontal, err := Distance(bbox.West, bbox.South, bbox.East, bbox.South, "")
+	if err != nil {
+		return nil, err
+	}
+	vertical, err := Distance(bbox.West, bbox.South, bbox.West, bbox.North, "")
+	if err != nil {
+		return nil, err
+	}
+
+	width := bboxWidth(bbox)
+	height := bbox.North - bbox.South
+	if horizontal >= vertical {
+		mid := (bbox.South + bbox.North) / 2
+		return geojson.NewBBox(bbox.West, math.Max(mid-width/2, -90), bbox.East, math.Min(mid+width/2, 90)), nil
+	}
+	mid := bbox.West + width/2
+	return geojson.NewBBox(common.NormalizeLng(mid-height/2), bbox.South, common.NormalizeLng(mid+height/2), bbox.North), nil
+}
+
+// BBoxScale scales the bbox around its center by the given factor. The latitudes are clamped to [-90, 90].
+func BBoxScale(bbox geojson.BBOX, factor float64) (*geojson.BBOX, error) {
+	if factor <= 0 {
+		return nil, errors.New("factor must be a positive number")
+	}
+
+	width := bboxWidth(bbox) * factor
+	height := (bbox.North - bbox.South) * factor
+	lng := bbox.West + bboxWidth(bbox)/2
+	lat := (bbox.South + bbox.North) / 2
+
+	res := geojson.BBOX{
+		South: math.Max(lat-height/2, -90),
+		North: math.Min(lat+height/2, 90),
+	}
+	if width >= 360 {
+		res.West, res.East = -180, 180
+		return &res, nil
+	}
+	res.West = common.NormalizeLng(lng - width/2)
+	res.East = res.West + width
+	if res.East > 180 {
+		res.East -= 360
+	}
+	return &res, nil
+}
+
+// BBoxIntersects returns true if the two bboxes overlap or touch.
+func BBoxIntersects(b1 geojson.BBOX, b2 geojson.BBOX) bool {
+	_, err := BBoxIntersection(b1, b2)
+	return err == nil
+}
+
+// BBoxIntersection returns the bbox shared by the two bboxes.
+// An error is returned if the bboxes don't intersect.
+func BBoxIntersection(b1 geojson.BBOX, b2 geojson.BBOX) (*geojson.BBOX, error) {
+	south := math.Max(b1.South, b2.South)
+	north := math.Min(b1.North, b2.North)
+	if south > north {
+		return nil, errors.New("the bboxes don't intersect")
+	}
+
+	w1, e1 := b1.West, b1.West+bboxWidth(b1)
+	for _, s := range []float64{0, -360, 360} {
+		w2, e2 := b2.West+s, b2.West+bboxWidth(b2)+s
+		west := math.Max(w1, w2)
+		east := math.Min(e1, e2)
+		if west > east {
+			continue
+		}
+		if east-west >= 360 {
+			return geojson.NewBBox(-180, south, 180, north), nil
+		}
+		west = common.NormalizeLng(west)
+		east = west + (math.Min(e1, e2) - math.Max(w1, w2))
+		if east > 180 {
+			east -= 360
+		}
+		return geojson.NewBBox(west, south, east, north), nil
+	}
+	return nil, errors.New("the bboxes don't intersect")
+}
+
+// BBoxUnion returns the smallest bbox that contains all the bboxes.
+// The result wraps around the 180th meridian if it is smaller that way.
+func BBoxUnion(bboxes []geojson.BBOX) (*geojson.BBOX, error) {
+	if len(bboxes) == 0 {
+		return nil, errors.New("at least one bbox is required")
+	}
+
+	south, north := math.Inf(1), math.Inf(-1)
+	arcs := [][2]float64{}
+	for _, b := range bboxes {
+		south = math.Min(south, b.South)
+		north = math.Max(north, b.North)
+		west := common.NormalizeLng(b.West)
+		arcs = append(arcs, [2]float64{west, west + bboxWidth(b)})
+	}
+	west, east := coveringArc(arcs)
+	return geojson.NewBBox(west, south, east, north), nil
+}
+
+// bboxWidth returns the width of the bbox in degrees of longitude, taking into account bboxes that wrap around the 180th meridian.
+func bboxWidth(bbox geojson.BBOX) float64 {
+	if bbox.West > bbox.East {
+		return bbox.East + 360 - bbox.West
+	}
+	return bbox.East - bbox.West
+}
@@ -308,8 +308,41 @@ func Along(ln geometry.LineString, distance float64, units string) (*geometry.Po
 	return &ln.Coordinates[len(ln.Coordinates)-1], nil
 }
 
-// BBoxPolygon takes a BoundingBox and returns an equivalent polygon.
+// BBoxPolygon takes a BoundingBox and returns an equivalent polygon, with the outer ring counter-clockwise.
+// A box that wraps around the 180th meridian, with West greater than East, is returned as a MultiPolygon
+// with one part on each side of the antimeridian.
+//
+// For compatibility with earlier versions, a box whose South and North are not latitudes in ascending order
+// is read the way those versions did, with the longitudes in South and North and the latitudes in West and East.
 func BBoxPolygon(bbox geojson.BBOX, id string) (*feature.Feature, error) {
+	if bbox.South > bbox.North || math.Abs(bbox.South) > 90 || math.Abs(bbox.North) > 90 {
+		return legacyBBoxPolygon(bbox, id)
+	}
+
+	ring := func(west float64, east float64) [][]float64 {
+		return [][]float64{
+			{west, bbox.South},
+			{east, bbox.South},
+			{east, bbox.North},
+			{west, bbox.North},
+			{west, bbox.South},
+		}
+	}
+	geom := geometry.Geometry{
+		GeoJSONType: geojson.Polygon,
+		Coordinates: [][][]float64{ring(bbox.West, bbox.East)},
+	}
+	if bbox.West > bbox.East {
+		geom = geometry.Geometry{
+			GeoJSONType: geojson.MultiPolygon,
+			Coordinates: [][][][]float64{{ring(bbox.West, 180)}, {ring(-180, bbox.East)}},
+		}
+	}
+	return feature.New(geom, []float64{bbox.West, bbox.South, bbox.East, bbox.North}, nil, id)
+}
+
+// legacyBBoxPolygon is the BBoxPolygon of earlier versions, which read the longitudes from South and North.
+func legacyBBoxPolygon(bbox geojson.BBOX, id string) (*feature.Feature, error) {
 
 	var cds [][][]float64
 	coords := [][]float64{
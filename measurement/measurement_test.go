@@ -2,6 +2,7 @@ package measurement
 
 import (
 	"errors"
+	"math"
 	"reflect"
 	"testing"
 
@@ -1560,3 +1561,258 @@ func TestRhumbDistance(t *testing.T) {
 		})
 	}
 }
+
+func TestAntimeridianBBox(t *testing.T) {
+	tests := map[string]struct {
+		geojson interface{}
+		want    []float64
+	}{
+		"pacific line": {
+			geojson: &geometry.LineString{Coordinates: []geometry.Point{{Lng: 140, Lat: 35}, {Lng: 175, Lat: 40}, {Lng: -150, Lat: 45}, {Lng: -123, Lat: 37}}},
+			want:    []float64{140, 35, -123, 45},
+		},
+		"not crossing": {
+			geojson: &geometry.LineString{Coordinates: []geometry.Point{{Lng: -10, Lat: 0}, {Lng: 10, Lat: 5}, {Lng: 170, Lat: 2}}},
+			want:    []float64{-10, 0, 170, 5},
+		},
+		"points": {
+			geojson: &geometry.MultiPoint{Coordinates: []geometry.Point{{Lng: 170, Lat: 0}, {Lng: -170, Lat: 10}}},
+			want:    []float64{170, 0, -170, 10},
+		},
+		"polygon ending at the antimeridian": {
+			geojson: &geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+				{Lng: 170, Lat: 0}, {Lng: 180, Lat: 0}, {Lng: 180, Lat: 10}, {Lng: 170, Lat: 10}, {Lng: 170, Lat: 0},
+			}}}},
+			want: []float64{170, 0, 180, 10},
+		},
+		"whole world": {
+			geojson: &geometry.LineString{Coordinates: []geometry.Point{{Lng: -180, Lat: 0}, {Lng: -60, Lat: 0}, {Lng: 60, Lat: 0}, {Lng: 180, Lat: 0}}},
+			want:    []float64{-180, 0, 180, 0},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := AntimeridianBBox(tt.geojson)
+			if err != nil {
+				t.Errorf("AntimeridianBBox() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AntimeridianBBox() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBBoxPolygonWrapped(t *testing.T) {
+	f, err := BBoxPolygon(geojson.BBOX{West: 170, South: -10, East: -170, North: 10}, "")
+	if err != nil {
+		t.Errorf("BBoxPolygon error: %v", err)
+	}
+	mp, err := f.ToMultiPolygon()
+	if err != nil {
+		t.Errorf("ToMultiPolygon error: %v", err)
+	}
+	assert.Equal(t, len(mp.Coordinates), 2)
+	assert.Equal(t, mp.Coordinates[0].Coordinates[0].Coordinates[0], geometry.Point{Lng: 170, Lat: -10})
+	assert.Equal(t, mp.Coordinates[0].Coordinates[0].Coordinates[2], geometry.Point{Lng: 180, Lat: 10})
+	assert.Equal(t, mp.Coordinates[1].Coordinates[0].Coordinates[0], geometry.Point{Lng: -180, Lat: -10})
+	assert.Equal(t, mp.Coordinates[1].Coordinates[0].Coordinates[2], geometry.Point{Lng: -170, Lat: 10})
+	assert.Equal(t, f.Bbox, []float64{170, -10, -170, 10})
+}
+
+func TestBBoxPolygonFromAntimeridianBBox(t *testing.T) {
+	ln := &geometry.LineString{Coordinates: []geometry.Point{{Lng: 140, Lat: 35}, {Lng: 175, Lat: 40}, {Lng: -150, Lat: 45}, {Lng: -123, Lat: 37}}}
+	b, err := AntimeridianBBox(ln)
+	if err != nil {
+		t.Errorf("AntimeridianBBox error: %v", err)
+	}
+	bbox, err := ToBBOX(b)
+	if err != nil {
+		t.Errorf("ToBBOX error: %v", err)
+	}
+	f, err := BBoxPolygon(*bbox, "")
+	if err != nil {
+		t.Errorf("BBoxPolygon error: %v", err)
+	}
+	mp, err := f.ToMultiPolygon()
+	if err != nil {
+		t.Errorf("ToMultiPolygon error: %v", err)
+	}
+	// the envelope covers the line on both sides of the antimeridian and the rings are counter-clockwise
+	assert.Equal(t, mp.Coordinates[0].Coordinates[0].Coordinates, []geometry.Point{
+		{Lng: 140, Lat: 35}, {Lng: 180, Lat: 35}, {Lng: 180, Lat: 45}, {Lng: 140, Lat: 45}, {Lng: 140, Lat: 35},
+	})
+	assert.Equal(t, mp.Coordinates[1].Coordinates[0].Coordinates, []geometry.Point{
+		{Lng: -180, Lat: 35}, {Lng: -123, Lat: 35}, {Lng: -123, Lat: 45}, {Lng: -180, Lat: 45}, {Lng: -180, Lat: 35},
+	})
+}
+
+func TestBBoxPolygonCounterClockwise(t *testing.T) {
+	f, err := BBoxPolygon(geojson.BBOX{West: 0, South: 40, East: 10, North: 50}, "")
+	if err != nil {
+		t.Errorf("BBoxPolygon error: %v", err)
+	}
+	poly, err := f.ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error: %v", err)
+	}
+	assert.Equal(t, poly.Coordinates[0].Coordinates, []geometry.Point{
+		{Lng: 0, Lat: 40}, {Lng: 10, Lat: 40}, {Lng: 10, Lat: 50}, {Lng: 0, Lat: 50}, {Lng: 0, Lat: 40},
+	})
+	assert.Equal(t, f.Bbox, []float64{0, 40, 10, 50})
+}
+
+func TestToBBOX(t *testing.T) {
+	b, err := ToBBOX([]float64{-10, -5, 0, 10, 5, 100})
+	if err != nil {
+		t.Errorf("ToBBOX error: %v", err)
+	}
+	assert.Equal(t, *b, geojson.BBOX{West: -10, South: -5, East: 10, North: 5})
+
+	_, err = ToBBOX([]float64{1, 2, 3})
+	assert.Equal(t, err.Error(), "bbox must have 4 or 6 values")
+}
+
+func TestBBoxExpand(t *testing.T) {
+	bbox := geojson.BBOX{West: 10, South: 60, East: 11, North: 61}
+	b, err := BBoxExpand(bbox, 500, constants.UnitMeters)
+	if err != nil {
+		t.Errorf("BBoxExpand error: %v", err)
+	}
+
+	// every edge is at least 500m away from the original bbox
+	for _, lat := range []float64{bbox.South, bbox.North} {
+		d, _ := PointDistance(geometry.Point{Lng: bbox.West, Lat: lat}, geometry.Point{Lng: b.West, Lat: lat}, constants.UnitMeters)
+		assert.True(t, d >= 499.999)
+		d, _ = PointDistance(geometry.Point{Lng: bbox.East, Lat: lat}, geometry.Point{Lng: b.East, Lat: lat}, constants.UnitMeters)
+		assert.True(t, d >= 499.999)
+	}
+	d, _ := PointDistance(geometry.Point{Lng: bbox.West, Lat: bbox.North}, geometry.Point{Lng: bbox.West, Lat: b.North}, constants.UnitMeters)
+	assert.True(t, math.Abs(d-500) < 1e-6)
+	d, _ = PointDistance(geometry.Point{Lng: bbox.West, Lat: bbox.South}, geometry.Point{Lng: bbox.West, Lat: b.South}, constants.UnitMeters)
+	assert.True(t, math.Abs(d-500) < 1e-6)
+	// at the northern edge the padding is exactly 500m
+	d, _ = PointDistance(geometry.Point{Lng: bbox.West, Lat: b.North}, geometry.Point{Lng: b.West, Lat: b.North}, constants.UnitMeters)
+	assert.True(t, math.Abs(d-500) < 1e-3)
+
+	b, err = BBoxExpand(geojson.BBOX{West: 179.999, South: 0, East: -179.999, North: 1}, 1, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("BBoxExpand error: %v", err)
+	}
+	assert.True(t, b.West < 179.999 && b.West > 179.98 && b.East > -179.999 && b.East < -179.98)
+
+	b, err = BBoxExpand(geojson.BBOX{West: 10, South: 89, East: 11, North: 89.5}, 100, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("BBoxExpand error: %v", err)
+	}
+	assert.Equal(t, b.North, 90.0)
+	assert.Equal(t, b.West, -180.0)
+	assert.Equal(t, b.East, 180.0)
+}
+
+func TestBBoxSquare(t *testing.T) {
+	tests := map[string]struct {
+		bbox geojson.BBOX
+		want geojson.BBOX
+	}{
+		"wide": {
+			bbox: geojson.BBOX{West: 0, South: 0, East: 10, North: 2},
+			want: geojson.BBOX{West: 0, South: -4, East: 10, North: 6},
+		},
+		"tall": {
+			bbox: geojson.BBOX{West: 0, South: 0, East: 2, North: 10},
+			want: geojson.BBOX{West: -4, South: 0, East: 6, North: 10},
+		},
+		"wide near the pole": {
+			bbox: geojson.BBOX{West: 0, South: 80, East: 40, North: 85},
+			want: geojson.BBOX{West: 0, South: 62.5, East: 40, North: 90},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := BBoxSquare(tt.bbox)
+			if err != nil {
+				t.Errorf("BBoxSquare() error = %v", err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("BBoxSquare() = %v, want %v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBBoxScale(t *testing.T) {
+	b, err := BBoxScale(geojson.BBOX{West: 0, South: 0, East: 10, North: 10}, 2)
+	if err != nil {
+		t.Errorf("BBoxScale error: %v", err)
+	}
+	assert.Equal(t, *b, geojson.BBOX{West: -5, South: -5, East: 15, North: 15})
+
+	b, err = BBoxScale(geojson.BBOX{West: 170, South: 0, East: -170, North: 10}, 0.5)
+	if err != nil {
+		t.Errorf("BBoxScale error: %v", err)
+	}
+	assert.Equal(t, *b, geojson.BBOX{West: 175, South: 2.5, East: -175, North: 7.5})
+
+	_, err = BBoxScale(geojson.BBOX{}, 0)
+	assert.Equal(t, err.Error(), "factor must be a positive number")
+}
+
+func TestBBoxIntersection(t *testing.T) {
+	tests := map[string]struct {
+		b1   geojson.BBOX
+		b2   geojson.BBOX
+		want *geojson.BBOX
+	}{
+		"overlapping": {
+			b1:   geojson.BBOX{West: 0, South: 0, East: 10, North: 10},
+			b2:   geojson.BBOX{West: 5, South: 5, East: 15, North: 15},
+			want: &geojson.BBOX{West: 5, South: 5, East: 10, North: 10},
+		},
+		"disjoint": {
+			b1:   geojson.BBOX{West: 0, South: 0, East: 10, North: 10},
+			b2:   geojson.BBOX{West: 20, South: 5, East: 30, North: 15},
+			want: nil,
+		},
+		"wrapped": {
+			b1:   geojson.BBOX{West: 170, South: 0, East: -170, North: 10},
+			b2:   geojson.BBOX{West: -175, South: 5, East: -160, North: 15},
+			want: &geojson.BBOX{West: -175, South: 5, East: -170, North: 10},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := BBoxIntersection(tt.b1, tt.b2)
+			if tt.want == nil {
+				if err == nil || BBoxIntersects(tt.b1, tt.b2) {
+					t.Errorf("BBoxIntersection() = %v, want no intersection", got)
+				}
+				return
+			}
+			if err != nil || !BBoxIntersects(tt.b1, tt.b2) {
+				t.Errorf("BBoxIntersection() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BBoxIntersection() = %v, want %v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestBBoxUnion(t *testing.T) {
+	b, err := BBoxUnion([]geojson.BBOX{{West: 0, South: 0, East: 10, North: 10}, {West: 20, South: -5, East: 30, North: 5}})
+	if err != nil {
+		t.Errorf("BBoxUnion error: %v", err)
+	}
+	assert.Equal(t, *b, geojson.BBOX{West: 0, South: -5, East: 30, North: 10})
+
+	b, err = BBoxUnion([]geojson.BBOX{{West: 160, South: 0, East: 170, North: 10}, {West: -170, South: 0, East: -160, North: 10}})
+	if err != nil {
+		t.Errorf("BBoxUnion error: %v", err)
+	}
+	assert.Equal(t, *b, geojson.BBOX{West: 160, South: 0, East: -160, North: 10})
+
+	_, err = BBoxUnion([]geojson.BBOX{})
+	assert.Equal(t, err.Error(), "at least one bbox is required")
+}
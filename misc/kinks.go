@@ -0,0 +1,173 @@
+package misc
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// Kinks takes a LineString, MultiLineString, Polygon or MultiPolygon and returns a FeatureCollection of the Points where it intersects itself.
+// All the rings of a Polygon are checked against each other, so holes that cross or touch the outer ring are reported as well.
+//
+// Examples:
+//
+//	fc, err := misc.Kinks(&geometry.Polygon{...})
+func Kinks(t interface{}) (*feature.Collection, error) {
+	lines, err := kinkLines(t)
+	if err != nil {
+		return nil, err
+	}
+	for i := range lines {
+		lines[i] = removeRepeated(lines[i])
+	}
+
+	pts := []geometry.Point{}
+	for i, l1 := range lines {
+		for j := i; j < len(lines); j++ {
+			l2 := lines[j]
+			for k := 0; k < len(l1)-1; k++ {
+				start := 0
+				if i == j {
+					start = k + 1
+				}
+				for m := start; m < len(l2)-1; m++ {
+					if i == j && isAdjacent(k, m, l1) {
+						continue
+					}
+					s1 := planar.Segment{A: l1[k], B: l1[k+1]}
+					s2 := planar.Segment{A: l2[m], B: l2[m+1]}
+					for _, p := range planar.Intersection(s1, s2) {
+						if !containsPoint(pts, p) {
+							pts = append(pts, p)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	fs := []feature.Feature{}
+	for _, p := range pts {
+		g := geometry.Geometry{
+			GeoJSONType: geojson.Point,
+			Coordinates: common.PointCoords(p),
+		}
+		f, err := feature.New(g, nil, nil, "")
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, *f)
+	}
+	return feature.NewFeatureCollection(fs)
+}
+
+// UnkinkPolygon takes a Polygon or MultiPolygon and splits every self-intersecting polygon into simple polygons.
+// The area is resolved with the even-odd rule across all the rings of a polygon, so holes are kept as holes
+// of the resulting polygons.
+func UnkinkPolygon(t interface{}) (*geometry.MultiPolygon, error) {
+	polys, err := common.Polygons(t)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []geometry.Polygon{}
+	for _, p := range polys {
+		rings := [][]geometry.Point{}
+		for _, r := range p.Coordinates {
+			rings = append(rings, r.Coordinates)
+		}
+		g := planar.Node(planar.PolygonSegments([]geometry.Polygon{p}))
+		result = append(result, g.Overlay(func(pt geometry.Point) bool {
+			return inEvenOdd(pt, rings)
+		})...)
+	}
+
+	return geometry.NewMultiPolygon(result)
+}
+
+// inEvenOdd determines if the point is enclosed by an odd number of rings.
+func inEvenOdd(pt geometry.Point, rings [][]geometry.Point) bool {
+	inside := false
+	for _, r := range rings {
+		if planar.InRing(pt, r) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func kinkLines(t interface{}) ([][]geometry.Point, error) {
+	switch gtp := t.(type) {
+	case *feature.Feature:
+		return kinkLines(&gtp.Geometry)
+	case *geometry.Geometry:
+		switch gtp.GeoJSONType {
+		case geojson.LineString, geojson.MultiLineString:
+			lines, err := common.Lines(gtp)
+			if err != nil {
+				return nil, err
+			}
+			return kinkLines(&geometry.MultiLineString{Coordinates: lines})
+		case geojson.Polygon, geojson.MultiPolygon:
+			polys, err := common.Polygons(gtp)
+			if err != nil {
+				return nil, err
+			}
+			return kinkLines(&geometry.MultiPolygon{Coordinates: polys})
+		}
+	case *geometry.LineString:
+		return [][]geometry.Point{gtp.Coordinates}, nil
+	case *geometry.MultiLineString:
+		lines := [][]geometry.Point{}
+		for _, l := range gtp.Coordinates {
+			lines = append(lines, l.Coordinates)
+		}
+		return lines, nil
+	case *geometry.Polygon:
+		return kinkLines(&geometry.MultiPolygon{Coordinates: []geometry.Polygon{*gtp}})
+	case *geometry.MultiPolygon:
+		lines := [][]geometry.Point{}
+		for _, p := range gtp.Coordinates {
+			for _, r := range p.Coordinates {
+				lines = append(lines, r.Coordinates)
+			}
+		}
+		return lines, nil
+	}
+	return nil, errors.New("geometry must be a LineString, MultiLineString, Polygon or MultiPolygon")
+}
+
+// isAdjacent reports whether two segments of the same line share an end point by construction.
+func isAdjacent(k int, m int, line []geometry.Point) bool {
+	if k == m || k-m == 1 || m-k == 1 {
+		return true
+	}
+	// the first and the last segment of a ring or a closed line meet at the closing position
+	last := len(line) - 2
+	closed := len(line) > 3 && line[0] == line[len(line)-1]
+	return closed && ((k == 0 && m == last) || (m == 0 && k == last))
+}
+
+// removeRepeated drops the consecutive duplicate positions of a line.
+func removeRepeated(pts []geometry.Point) []geometry.Point {
+	res := []geometry.Point{}
+	for i, p := range pts {
+		if i == 0 || p != pts[i-1] {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+func containsPoint(pts []geometry.Point, p geometry.Point) bool {
+	for _, v := range pts {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package misc
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+func TestKinksLineString(t *testing.T) {
+	ln := geometry.LineString{
+		Coordinates: []geometry.Point{
+			{Lng: 0, Lat: 0},
+			{Lng: 2, Lat: 2},
+			{Lng: 2, Lat: 0},
+			{Lng: 0, Lat: 2},
+		},
+	}
+	fc, err := Kinks(&ln)
+	assert.Nil(t, err)
+	assert.Equal(t, len(fc.Features), 1)
+	p, err := fc.Features[0].ToPoint()
+	assert.Nil(t, err)
+	assert.Equal(t, *p, geometry.Point{Lng: 1, Lat: 1})
+}
+
+func TestKinksClosedLineString(t *testing.T) {
+	// the first and the last segment meet at the closing position, which is not a kink
+	ln := geometry.LineString{
+		Coordinates: []geometry.Point{
+			{Lng: 0, Lat: 0},
+			{Lng: 1, Lat: 0},
+			{Lng: 1, Lat: 1},
+			{Lng: 0, Lat: 1},
+			{Lng: 0, Lat: 0},
+		},
+	}
+	fc, err := Kinks(&ln)
+	assert.Nil(t, err)
+	assert.Equal(t, len(fc.Features), 0)
+}
+
+func TestKinksSimplePolygon(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]]] } }")
+	assert.Nil(t, err)
+	fc, err := Kinks(f)
+	assert.Nil(t, err)
+	assert.Equal(t, len(fc.Features), 0)
+}
+
+func TestKinksPolygonWithHoles(t *testing.T) {
+	// the hole crosses the outer ring twice
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]], [[8, 2], [12, 2], [12, 4], [8, 4], [8, 2]]] } }")
+	assert.Nil(t, err)
+	fc, err := Kinks(f)
+	assert.Nil(t, err)
+	assert.Equal(t, len(fc.Features), 2)
+}
+
+func TestKinksInvalidGeometry(t *testing.T) {
+	_, err := Kinks(&geometry.Point{Lng: 1, Lat: 1})
+	assert.Equal(t, err.Error(), "geometry must be a LineString, MultiLineString, Polygon or MultiPolygon")
+}
+
+func TestUnkinkPolygon(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [2, 2], [2, 0], [0, 2], [0, 0]]] } }")
+	assert.Nil(t, err)
+	mp, err := UnkinkPolygon(f)
+	assert.Nil(t, err)
+	assert.Equal(t, len(mp.Coordinates), 2)
+	for _, p := range mp.Coordinates {
+		fc, err := Kinks(&p)
+		assert.Nil(t, err)
+		assert.Equal(t, len(fc.Features), 0)
+	}
+}
+
+func TestUnkinkPolygonWithHole(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]], [[2, 2], [4, 2], [4, 4], [2, 4], [2, 2]]] } }")
+	assert.Nil(t, err)
+	mp, err := UnkinkPolygon(f)
+	assert.Nil(t, err)
+	assert.Equal(t, len(mp.Coordinates), 1)
+	assert.Equal(t, len(mp.Coordinates[0].Coordinates), 2)
+
+	poly, err := f.Geometry.ToPolygon()
+	assert.Nil(t, err)
+	want, err := measurement.Area(poly)
+	assert.Nil(t, err)
+	got, err := measurement.Area(mp)
+	assert.Nil(t, err)
+	assert.True(t, want-got < 1e-3 && got-want < 1e-3)
+}
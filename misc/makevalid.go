@@ -0,0 +1,62 @@
+package misc
+
+import (
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// MakeValid repairs an invalid Polygon or MultiPolygon and returns the equivalent valid MultiPolygon.
+// Repeated positions are removed, unclosed rings are closed, self-intersecting rings are split at their kinks
+// and rings are rewound so that outer rings are counter-clockwise and holes clockwise.
+// The area of each polygon is resolved with the even-odd rule across its rings, and overlapping polygons
+// of a MultiPolygon are merged, so the area of valid parts is preserved.
+//
+// Examples:
+//
+//	mp, err := misc.MakeValid(f)
+func MakeValid(t interface{}) (*geometry.MultiPolygon, error) {
+	polys, err := common.RawPolygons(t)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []geometry.Polygon{}
+	for _, p := range polys {
+		rings := [][]geometry.Point{}
+		for _, r := range p.Coordinates {
+			if ring := cleanRing(r.Coordinates); ring != nil {
+				rings = append(rings, ring)
+			}
+		}
+		if len(rings) == 0 {
+			continue
+		}
+
+		segs := []planar.Segment{}
+		for _, r := range rings {
+			segs = append(segs, planar.Segments(r)...)
+		}
+		result = append(result, planar.Node(segs).Overlay(func(pt geometry.Point) bool {
+			return inEvenOdd(pt, rings)
+		})...)
+	}
+
+	if len(polys) > 1 && len(result) > 1 {
+		result = planar.Union(result)
+	}
+	return geometry.NewMultiPolygon(result)
+}
+
+// cleanRing removes the repeated positions of a ring and closes it.
+// It returns nil for rings that can't enclose an area.
+func cleanRing(r []geometry.Point) []geometry.Point {
+	ring := removeRepeated(r)
+	if len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+		ring = ring[:len(ring)-1]
+	}
+	if len(ring) < 3 {
+		return nil
+	}
+	return append(ring, ring[0])
+}
@@ -0,0 +1,89 @@
+package misc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/booleans"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+func TestMakeValid(t *testing.T) {
+	square, err := measurement.Area(&geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 1}, {Lng: 0, Lat: 0},
+	}}}})
+	assert.Nil(t, err)
+
+	tests := map[string]struct {
+		geojson   string
+		polygons  int
+		wantArea  float64
+		tolerance float64
+	}{
+		"unclosed ring": {
+			geojson:  "{ \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [1, 0], [1, 1], [0, 1]]] }",
+			polygons: 1,
+			wantArea: square,
+		},
+		"duplicate points": {
+			geojson:  "{ \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [1, 0], [1, 0], [1, 1], [0, 1], [0, 1], [0, 0]]] }",
+			polygons: 1,
+			wantArea: square,
+		},
+		"wrong winding": {
+			geojson:  "{ \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [0, 1], [1, 1], [1, 0], [0, 0]]] }",
+			polygons: 1,
+			wantArea: square,
+		},
+		"bowtie": {
+			geojson:  "{ \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [1, 1], [1, 0], [0, 1], [0, 0]]] }",
+			polygons: 2,
+			wantArea: square / 2,
+		},
+		"overlapping polygons": {
+			geojson:  "{ \"type\": \"MultiPolygon\", \"coordinates\": [[[[0, 0], [1, 0], [1, 1], [0, 1], [0, 0]]], [[[0.5, 0], [1, 0], [1, 1], [0.5, 1], [0.5, 0]]]] }",
+			polygons: 1,
+			wantArea: square,
+		},
+		"hole touching the shell": {
+			geojson:  "{ \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [1, 0], [1, 1], [0, 1], [0, 0]], [[0, 0], [0.5, 0], [0.5, 0.5], [0, 0.5], [0, 0]]] }",
+			polygons: 1,
+			wantArea: square * 3 / 4,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := geometry.FromJSON(tt.geojson)
+			assert.Nil(t, err)
+			mp, err := MakeValid(g)
+			assert.Nil(t, err)
+			assert.Equal(t, len(mp.Coordinates), tt.polygons)
+
+			valid, err := booleans.Valid(mp)
+			assert.Nil(t, err)
+			assert.True(t, valid)
+
+			area, err := measurement.Area(mp)
+			assert.Nil(t, err)
+			// the spherical area of the pieces differs slightly from the proportion of the planar area
+			assert.True(t, math.Abs(area-tt.wantArea)/tt.wantArea < 1e-3)
+		})
+	}
+}
+
+func TestMakeValidFeature(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [2, 2], [2, 0], [0, 2]]] } }")
+	assert.Nil(t, err)
+	mp, err := MakeValid(f)
+	assert.Nil(t, err)
+	assert.Equal(t, len(mp.Coordinates), 2)
+}
+
+func TestMakeValidInvalidGeometry(t *testing.T) {
+	_, err := MakeValid(&geometry.Point{Lng: 1, Lat: 1})
+	assert.Equal(t, err.Error(), "geometry must be a Polygon or a MultiPolygon")
+}
@@ -0,0 +1,116 @@
+package misc
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// world is the outer ring of the default mask.
+var world = []geometry.Point{{Lng: -180, Lat: -90}, {Lng: 180, Lat: -90}, {Lng: 180, Lat: 90}, {Lng: -180, Lat: 90}, {Lng: -180, Lat: -90}}
+
+// Mask returns a Polygon feature covering the world, or the outer ring of the mask polygon if it is not nil, with the
+// polygons punched out as holes. The polygons are a FeatureCollection of Polygon or MultiPolygon features, or a single
+// Polygon or MultiPolygon. Overlapping polygons are merged so that the holes don't overlap, and the parts of the polygons
+// outside of the mask are cut off. If the polygons split the mask into several parts, for instance where the holes of
+// the polygons show through it as islands, the largest part is returned and the others can be read with MaskIslands.
+//
+// Examples:
+//
+//	f, err := misc.Mask(serviceArea, nil)
+func Mask(polygons interface{}, mask *geometry.Polygon) (*feature.Feature, error) {
+	parts, err := maskParts(polygons, mask)
+	if err != nil {
+		return nil, err
+	}
+	return feature.New(geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: common.PolygonCoords(parts[0])}, nil, nil, "")
+}
+
+// MaskIslands returns the parts of the mask that Mask leaves out as a FeatureCollection of Polygon features, such as
+// the holes of the polygons and the rings enclosed by polygons that are merged. The collection is empty if the mask
+// is a single Polygon.
+//
+// Examples:
+//
+//	islands, err := misc.MaskIslands(serviceArea, nil)
+func MaskIslands(polygons interface{}, mask *geometry.Polygon) (*feature.Collection, error) {
+	parts, err := maskParts(polygons, mask)
+	if err != nil {
+		return nil, err
+	}
+	features := []feature.Feature{}
+	for _, p := range parts[1:] {
+		f, err := feature.New(geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: common.PolygonCoords(p)}, nil, nil, "")
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// maskParts returns the area inside the mask and outside of every polygon, with the largest part first.
+func maskParts(polygons interface{}, mask *geometry.Polygon) ([]geometry.Polygon, error) {
+	polys := []geometry.Polygon{}
+	if fc, ok := polygons.(*feature.Collection); ok {
+		for i := range fc.Features {
+			p, err := common.Polygons(&fc.Features[i])
+			if err != nil {
+				return nil, err
+			}
+			polys = append(polys, p...)
+		}
+	} else {
+		p, err := common.Polygons(polygons)
+		if err != nil {
+			return nil, err
+		}
+		polys = p
+	}
+
+	outer := world
+	if mask != nil {
+		if len(mask.Coordinates) == 0 {
+			return nil, errors.New("mask must have an outer ring")
+		}
+		outer = mask.Coordinates[0].Coordinates
+	}
+	if len(polys) == 0 {
+		return []geometry.Polygon{{Coordinates: []geometry.LineString{{Coordinates: outer}}}}, nil
+	}
+
+	// the area inside the mask and outside of every polygon, traced along the edges of both
+	boxes := make([][4]float64, len(polys))
+	for i, p := range polys {
+		boxes[i] = planar.Bounds(p)
+	}
+	segs := append(planar.Segments(outer), planar.PolygonSegments(polys)...)
+	res := planar.Node(segs).Overlay(func(pt geometry.Point) bool {
+		if !planar.InRing(pt, outer) {
+			return false
+		}
+		for i, p := range polys {
+			if planar.InBounds(pt, boxes[i]) && planar.InPolygon(pt, p) {
+				return false
+			}
+		}
+		return true
+	})
+	if len(res) == 0 {
+		return nil, errors.New("the polygons cover the whole mask")
+	}
+
+	largest := 0
+	for i := range res {
+		if math.Abs(planar.SignedArea(res[i].Coordinates[0].Coordinates)) > math.Abs(planar.SignedArea(res[largest].Coordinates[0].Coordinates)) {
+			largest = i
+		}
+	}
+	res[0], res[largest] = res[largest], res[0]
+	return res, nil
+}
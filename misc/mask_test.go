@@ -0,0 +1,158 @@
+package misc
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/booleans"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+func TestMask(t *testing.T) {
+	// the first two squares overlap and are merged into one hole
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [2, 0], [2, 2], [0, 2], [0, 0]]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"MultiPolygon\", \"coordinates\": [[[[1, 1], [3, 1], [3, 3], [1, 3], [1, 1]]], [[[10, 10], [11, 10], [11, 11], [10, 11], [10, 10]]]] } }" +
+		"] }")
+	assert.Nil(t, err)
+	mask := &geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: -20, Lat: -20}, {Lng: 20, Lat: -20}, {Lng: 20, Lat: 20}, {Lng: -20, Lat: 20}, {Lng: -20, Lat: -20},
+	}}}}
+
+	tests := map[string]struct {
+		mask  *geometry.Polygon
+		outer []geometry.Point
+	}{
+		"world": {
+			mask:  nil,
+			outer: world,
+		},
+		"mask": {
+			mask:  mask,
+			outer: mask.Coordinates[0].Coordinates,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := Mask(fc, tt.mask)
+			assert.Nil(t, err)
+			p, err := f.ToPolygon()
+			assert.Nil(t, err)
+			assert.Equal(t, len(p.Coordinates), 3)
+			assert.Equal(t, p.Coordinates[0].Coordinates, tt.outer)
+
+			holes := 0.0
+			for _, r := range p.Coordinates[1:] {
+				a := planar.SignedArea(r.Coordinates)
+				assert.True(t, a < 0)
+				holes -= a
+			}
+			assert.Equal(t, holes, 8.0)
+		})
+	}
+}
+
+func TestMaskSinglePolygon(t *testing.T) {
+	poly := &geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 0, Lat: 1}, {Lng: 1, Lat: 1}, {Lng: 1, Lat: 0}, {Lng: 0, Lat: 0},
+	}}}}
+	f, err := Mask(poly, nil)
+	assert.Nil(t, err)
+	p, err := f.ToPolygon()
+	assert.Nil(t, err)
+	assert.Equal(t, len(p.Coordinates), 2)
+	assert.Equal(t, planar.SignedArea(p.Coordinates[1].Coordinates), -1.0)
+
+	fc, err := MaskIslands(poly, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, len(fc.Features), 0)
+
+	_, err = Mask(&geometry.Point{}, nil)
+	assert.Equal(t, err.Error(), "geometry must be a Polygon or a MultiPolygon")
+}
+
+func TestMaskIslands(t *testing.T) {
+	tests := map[string]struct {
+		polygons string
+		islands  float64
+	}{
+		"hole": {
+			polygons: "{ \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]], [[4, 4], [4, 6], [6, 6], [6, 4], [4, 4]]] }",
+			islands:  4,
+		},
+		"merged frame": {
+			polygons: "{ \"type\": \"MultiPolygon\", \"coordinates\": [" +
+				"[[[0, 0], [3, 0], [3, 1], [0, 1], [0, 0]]], [[[0, 2], [3, 2], [3, 3], [0, 3], [0, 2]]]," +
+				"[[[0, 0], [1, 0], [1, 3], [0, 3], [0, 0]]], [[[2, 0], [3, 0], [3, 3], [2, 3], [2, 0]]]] }",
+			islands: 1,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := geometry.FromJSON(tt.polygons)
+			assert.Nil(t, err)
+			polys, err := common.Polygons(g)
+			assert.Nil(t, err)
+			f, err := Mask(&geometry.MultiPolygon{Coordinates: polys}, nil)
+			assert.Nil(t, err)
+			valid, err := booleans.Valid(f)
+			assert.Nil(t, err)
+			assert.True(t, valid)
+
+			// the world with one hole
+			p, err := f.ToPolygon()
+			assert.Nil(t, err)
+			assert.Equal(t, len(p.Coordinates), 2)
+			assert.Equal(t, p.Coordinates[0].Coordinates, world)
+
+			// and the island showing through it
+			fc, err := MaskIslands(&geometry.MultiPolygon{Coordinates: polys}, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, len(fc.Features), 1)
+			island, err := fc.Features[0].ToPolygon()
+			assert.Nil(t, err)
+			assert.Equal(t, len(island.Coordinates), 1)
+			assert.Equal(t, planar.SignedArea(island.Coordinates[0].Coordinates), tt.islands)
+		})
+	}
+}
+
+func TestMaskClipped(t *testing.T) {
+	mask := &geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: -20, Lat: -20}, {Lng: 20, Lat: -20}, {Lng: 20, Lat: 20}, {Lng: -20, Lat: 20}, {Lng: -20, Lat: -20},
+	}}}}
+	tests := map[string]struct {
+		polygon geometry.LineString
+		area    float64
+	}{
+		"partly outside": {
+			polygon: geometry.LineString{Coordinates: []geometry.Point{{Lng: 15, Lat: 0}, {Lng: 25, Lat: 0}, {Lng: 25, Lat: 5}, {Lng: 15, Lat: 5}, {Lng: 15, Lat: 0}}},
+			area:    1575,
+		},
+		"outside": {
+			polygon: geometry.LineString{Coordinates: []geometry.Point{{Lng: 30, Lat: 0}, {Lng: 40, Lat: 0}, {Lng: 40, Lat: 5}, {Lng: 30, Lat: 5}, {Lng: 30, Lat: 0}}},
+			area:    1600,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := Mask(&geometry.Polygon{Coordinates: []geometry.LineString{tt.polygon}}, mask)
+			assert.Nil(t, err)
+			valid, err := booleans.Valid(f)
+			assert.Nil(t, err)
+			assert.True(t, valid)
+
+			// the polygon is cut into the outer ring rather than left as a hole outside of it
+			p, err := f.ToPolygon()
+			assert.Nil(t, err)
+			assert.Equal(t, len(p.Coordinates), 1)
+			assert.Equal(t, planar.SignedArea(p.Coordinates[0].Coordinates), tt.area)
+		})
+	}
+
+	_, err := Mask(mask, mask)
+	assert.Equal(t, err.Error(), "the polygons cover the whole mask")
+}
@@ -0,0 +1,266 @@
+package misc
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	turf "github.com/tomchavakis/turf-go"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/conversions"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+// maxCells is the largest grid ShortestPath searches.
+const maxCells = 10000000
+
+// ShortestPathOptions ...
+type ShortestPathOptions struct {
+	// Units of the resolution. constants.UnitKilometers is the default value
+	Units string
+	// Resolution is the distance between the points of the grid the path is searched on. 100 is the default value
+	Resolution *float64
+}
+
+// ShortestPath returns the shortest LineString from the start to the end that goes around the obstacles.
+// The path is searched with A* on a grid of the given resolution, moving to any of the eight neighbouring points
+// that is not inside an obstacle. The grid covers the start, the end and the obstacles with a margin of two points
+// on every side, and straight runs of the path are merged into single segments.
+// obstacles can be nil or a FeatureCollection of Polygon and MultiPolygon features.
+//
+// Examples:
+//
+//	f, err := misc.ShortestPath(start, end, buildings, misc.ShortestPathOptions{Units: constants.UnitMeters, Resolution: common.Float64Ptr(5)})
+//	path, err := f.ToLineString()
+func ShortestPath(start geometry.Point, end geometry.Point, obstacles *feature.Collection, options ShortestPathOptions) (*feature.Feature, error) {
+	if options.Units == "" {
+		options.Units = constants.UnitKilometers
+	}
+	if options.Resolution == nil {
+		options.Resolution = common.Float64Ptr(100)
+	}
+	if *options.Resolution <= 0 {
+		return nil, errors.New("resolution must be a positive number")
+	}
+
+	polys := []geometry.Polygon{}
+	if obstacles != nil {
+		for i := range obstacles.Features {
+			p, err := common.Polygons(&obstacles.Features[i])
+			if err != nil {
+				return nil, err
+			}
+			polys = append(polys, p...)
+		}
+	}
+	for _, p := range polys {
+		if in, err := turf.PointInPolygon(start, p); err == nil && in {
+			return nil, errors.New("start must be outside of the obstacles")
+		}
+		if in, err := turf.PointInPolygon(end, p); err == nil && in {
+			return nil, errors.New("end must be outside of the obstacles")
+		}
+	}
+
+	bbox := geojson.BBOX{West: math.Min(start.Lng, end.Lng), South: math.Min(start.Lat, end.Lat), East: math.Max(start.Lng, end.Lng), North: math.Max(start.Lat, end.Lat)}
+	for _, p := range polys {
+		b := ringBBox(p.Coordinates[0].Coordinates)
+		bbox = geojson.BBOX{West: math.Min(bbox.West, b.West), South: math.Min(bbox.South, b.South), East: math.Max(bbox.East, b.East), North: math.Max(bbox.North, b.North)}
+	}
+
+	dLat, err := conversions.LengthToDegrees(*options.Resolution, options.Units)
+	if err != nil {
+		return nil, err
+	}
+	dLng := dLat / math.Max(math.Cos((bbox.South+bbox.North)/2*math.Pi/180), 1e-6)
+	west, south := bbox.West-2*dLng, bbox.South-2*dLat
+	columns := int(math.Ceil((bbox.East-bbox.West)/dLng)) + 5
+	rows := int(math.Ceil((bbox.North-bbox.South)/dLat)) + 5
+	if float64(columns)*float64(rows) > maxCells {
+		return nil, errors.New("the resolution is too small for the area")
+	}
+
+	g := &pathGrid{
+		columns: columns,
+		rows:    rows,
+		blocked: make([]bool, columns*rows),
+		point: func(n int) geometry.Point {
+			return geometry.Point{Lng: west + float64(n%columns)*dLng, Lat: south + float64(n/columns)*dLat}
+		},
+	}
+	for _, p := range polys {
+		b := ringBBox(p.Coordinates[0].Coordinates)
+		for r := int(math.Floor((b.South - south) / dLat)); r <= int(math.Ceil((b.North-south)/dLat)); r++ {
+			for c := int(math.Floor((b.West - west) / dLng)); c <= int(math.Ceil((b.East-west)/dLng)); c++ {
+				n := r*columns + c
+				if g.blocked[n] {
+					continue
+				}
+				in, err := turf.PointInPolygon(g.point(n), p)
+				if err != nil {
+					return nil, err
+				}
+				g.blocked[n] = in
+			}
+		}
+	}
+
+	// the start and the end are outside of the obstacles but the grid points around them can be inside
+	from, ok := g.nearest((start.Lng-west)/dLng, (start.Lat-south)/dLat)
+	if !ok {
+		return nil, errors.New("no path found")
+	}
+	to, ok := g.nearest((end.Lng-west)/dLng, (end.Lat-south)/dLat)
+	if !ok {
+		return nil, errors.New("no path found")
+	}
+
+	cells, ok := g.search(from, to)
+	if !ok {
+		return nil, errors.New("no path found")
+	}
+
+	path := []geometry.Point{start}
+	for i, n := range cells {
+		// keep only the points where the direction changes
+		if i > 0 && i < len(cells)-1 && cells[i]-cells[i-1] == cells[i+1]-cells[i] {
+			continue
+		}
+		if p := g.point(n); p != path[len(path)-1] {
+			path = append(path, p)
+		}
+	}
+	if end != path[len(path)-1] {
+		path = append(path, end)
+	}
+	if len(path) == 1 {
+		path = append(path, end)
+	}
+
+	geom := geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: common.LineStringCoords(path)}
+	return feature.New(geom, nil, nil, "")
+}
+
+// pathGrid is a grid of points stored row by row from the south west corner.
+type pathGrid struct {
+	columns int
+	rows    int
+	blocked []bool
+	point   func(int) geometry.Point
+}
+
+// nearest returns the point of the grid that is not blocked and is nearest to the position x, y, measured in
+// grid spacings from the south west corner. The rings of points around the position are searched outwards.
+func (g *pathGrid) nearest(x float64, y float64) (int, bool) {
+	c0, r0 := int(math.Round(x)), int(math.Round(y))
+	best, bestDist := -1, math.Inf(1)
+	for k := 0; k < g.columns || k < g.rows; k++ {
+		// the points of ring k are at least k - 0.5 spacings away
+		if float64(k)-0.5 > bestDist {
+			break
+		}
+		for r := r0 - k; r <= r0+k; r++ {
+			for c := c0 - k; c <= c0+k; c++ {
+				if (r != r0-k && r != r0+k && c != c0-k && c != c0+k) || c < 0 || r < 0 || c >= g.columns || r >= g.rows {
+					continue
+				}
+				n := r*g.columns + c
+				if g.blocked[n] {
+					continue
+				}
+				if d := math.Hypot(x-float64(c), y-float64(r)); d < bestDist {
+					best, bestDist = n, d
+				}
+			}
+		}
+	}
+	return best, best >= 0
+}
+
+// search runs A* from one point of the grid to the other and returns the points of the path.
+// Steps are measured in grid spacings and diagonal steps can't cut the corners of blocked points.
+func (g *pathGrid) search(from int, to int) ([]int, bool) {
+	tc, tr := to%g.columns, to/g.columns
+	heuristic := func(n int) float64 {
+		dx := math.Abs(float64(n%g.columns - tc))
+		dy := math.Abs(float64(n/g.columns - tr))
+		return math.Max(dx, dy) + (math.Sqrt2-1)*math.Min(dx, dy)
+	}
+
+	costs := map[int]float64{from: 0}
+	prev := map[int]int{}
+	done := map[int]bool{}
+	q := &pathQueue{{cell: from, priority: heuristic(from)}}
+	for q.Len() > 0 {
+		n := heap.Pop(q).(pathItem).cell
+		if done[n] {
+			continue
+		}
+		done[n] = true
+		if n == to {
+			cells := []int{to}
+			for c := to; c != from; {
+				c = prev[c]
+				cells = append([]int{c}, cells...)
+			}
+			return cells, true
+		}
+
+		c, r := n%g.columns, n/g.columns
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				nc, nr := c+dx, r+dy
+				if (dx == 0 && dy == 0) || nc < 0 || nr < 0 || nc >= g.columns || nr >= g.rows {
+					continue
+				}
+				m := nr*g.columns + nc
+				if g.blocked[m] || (dx != 0 && dy != 0 && (g.blocked[r*g.columns+nc] || g.blocked[nr*g.columns+c])) {
+					continue
+				}
+				step := 1.0
+				if dx != 0 && dy != 0 {
+					step = math.Sqrt2
+				}
+				if cost, ok := costs[m]; !ok || costs[n]+step < cost {
+					costs[m] = costs[n] + step
+					prev[m] = n
+					heap.Push(q, pathItem{cell: m, priority: costs[m] + heuristic(m)})
+				}
+			}
+		}
+	}
+	return nil, false
+}
+
+func ringBBox(ring []geometry.Point) geojson.BBOX {
+	b := geojson.BBOX{West: math.Inf(1), South: math.Inf(1), East: math.Inf(-1), North: math.Inf(-1)}
+	for _, p := range ring {
+		b.West = math.Min(b.West, p.Lng)
+		b.South = math.Min(b.South, p.Lat)
+		b.East = math.Max(b.East, p.Lng)
+		b.North = math.Max(b.North, p.Lat)
+	}
+	return b
+}
+
+type pathItem struct {
+	cell     int
+	priority float64
+}
+
+// pathQueue is a priority queue of grid points, with the lowest priority first.
+type pathQueue []pathItem
+
+func (q pathQueue) Len() int            { return len(q) }
+func (q pathQueue) Less(i, j int) bool  { return q[i].priority < q[j].priority }
+func (q pathQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *pathQueue) Push(x interface{}) { *q = append(*q, x.(pathItem)) }
+func (q *pathQueue) Pop() interface{} {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
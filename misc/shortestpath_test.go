@@ -0,0 +1,141 @@
+package misc
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	turf "github.com/tomchavakis/turf-go"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/conversions"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+func TestShortestPathWithoutObstacles(t *testing.T) {
+	start := geometry.Point{Lng: 0, Lat: 0}
+	end := geometry.Point{Lng: 0.1, Lat: 0.05}
+	f, err := ShortestPath(start, end, nil, ShortestPathOptions{Resolution: common.Float64Ptr(1)})
+	assert.Nil(t, err)
+	ln, err := f.ToLineString()
+	assert.Nil(t, err)
+	assert.Equal(t, ln.Coordinates[0], start)
+	assert.Equal(t, ln.Coordinates[len(ln.Coordinates)-1], end)
+
+	length, err := measurement.Length(*ln, constants.UnitKilometers)
+	assert.Nil(t, err)
+	straight, err := measurement.PointDistance(start, end, constants.UnitKilometers)
+	assert.Nil(t, err)
+	// moving between the points of the grid takes up to 8% longer than the straight line, plus the snapping of the ends
+	if length > straight*1.15 {
+		t.Errorf("path length %v, straight distance %v", length, straight)
+	}
+}
+
+func TestShortestPathAroundObstacles(t *testing.T) {
+	// a wall between the start and the end with a gap in the north
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0.04, -0.1], [0.06, -0.1], [0.06, 0.05], [0.04, 0.05], [0.04, -0.1]]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"MultiPolygon\", \"coordinates\": [[[[0.04, 0.1], [0.06, 0.1], [0.06, 0.2], [0.04, 0.2], [0.04, 0.1]]]] } }" +
+		"] }")
+	assert.Nil(t, err)
+
+	start := geometry.Point{Lng: 0, Lat: 0}
+	end := geometry.Point{Lng: 0.1, Lat: 0}
+	f, err := ShortestPath(start, end, fc, ShortestPathOptions{Units: constants.UnitMeters, Resolution: common.Float64Ptr(500)})
+	assert.Nil(t, err)
+	ln, err := f.ToLineString()
+	assert.Nil(t, err)
+	assert.Equal(t, ln.Coordinates[0], start)
+	assert.Equal(t, ln.Coordinates[len(ln.Coordinates)-1], end)
+
+	// the path goes through the gap
+	through := false
+	for _, p := range ln.Coordinates {
+		if p.Lat > 0.05 && p.Lat < 0.1 {
+			through = true
+		}
+		for i := range fc.Features {
+			polys, err := common.Polygons(&fc.Features[i])
+			assert.Nil(t, err)
+			in, err := turf.PointInPolygon(p, polys[0])
+			assert.Nil(t, err)
+			assert.True(t, !in)
+		}
+	}
+	assert.True(t, through)
+}
+
+func TestShortestPathNearObstacle(t *testing.T) {
+	// the start is a tenth of a grid spacing from the obstacle and its nearest grid point is inside it
+	d, err := conversions.LengthToDegrees(1, constants.UnitKilometers)
+	assert.Nil(t, err)
+	obstacle := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 2.8 * d, Lat: -0.05}, {Lng: 5 * d, Lat: -0.05}, {Lng: 5 * d, Lat: 0.05}, {Lng: 2.8 * d, Lat: 0.05}, {Lng: 2.8 * d, Lat: -0.05},
+	}}}}
+	f, err := feature.New(geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: common.PolygonCoords(obstacle)}, nil, nil, "")
+	assert.Nil(t, err)
+	fc, err := feature.NewFeatureCollection([]feature.Feature{*f})
+	assert.Nil(t, err)
+
+	start := geometry.Point{Lng: 2.7 * d, Lat: 0}
+	end := geometry.Point{Lng: 0, Lat: 0}
+	res, err := ShortestPath(start, end, fc, ShortestPathOptions{Resolution: common.Float64Ptr(1)})
+	assert.Nil(t, err)
+	ln, err := res.ToLineString()
+	assert.Nil(t, err)
+	assert.Equal(t, ln.Coordinates[0], start)
+	for _, p := range ln.Coordinates {
+		in, err := turf.PointInPolygon(p, obstacle)
+		assert.Nil(t, err)
+		assert.True(t, !in)
+	}
+}
+
+func TestShortestPathErrors(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[-1, -1], [1, -1], [1, 1], [-1, 1], [-1, -1]], [[-0.5, -0.5], [-0.5, 0.5], [0.5, 0.5], [0.5, -0.5], [-0.5, -0.5]]] } }" +
+		"] }")
+	assert.Nil(t, err)
+
+	tests := map[string]struct {
+		start      geometry.Point
+		end        geometry.Point
+		resolution float64
+		err        string
+	}{
+		"no path": {
+			start:      geometry.Point{Lng: 0, Lat: 0},
+			end:        geometry.Point{Lng: 2, Lat: 0},
+			resolution: 10,
+			err:        "no path found",
+		},
+		"start inside an obstacle": {
+			start:      geometry.Point{Lng: 0.75, Lat: 0},
+			end:        geometry.Point{Lng: 2, Lat: 0},
+			resolution: 10,
+			err:        "start must be outside of the obstacles",
+		},
+		"end inside an obstacle": {
+			start:      geometry.Point{Lng: 2, Lat: 0},
+			end:        geometry.Point{Lng: 0.75, Lat: 0},
+			resolution: 10,
+			err:        "end must be outside of the obstacles",
+		},
+		"invalid resolution": {
+			start:      geometry.Point{Lng: 0, Lat: 0},
+			end:        geometry.Point{Lng: 2, Lat: 0},
+			resolution: 0,
+			err:        "resolution must be a positive number",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ShortestPath(tt.start, tt.end, fc, ShortestPathOptions{Resolution: common.Float64Ptr(tt.resolution)})
+			assert.Equal(t, err.Error(), tt.err)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package mutation
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+// CleanCoordsOptions ...
+type CleanCoordsOptions struct {
+	// Mutate allows the input GeoJSON to be changed in place, otherwise a clone is cleaned. false is the default value
+	Mutate bool
+}
+
+// CleanCoords removes redundant coordinates from any GeoJSON geometry: repeated positions and positions that lie on the
+// straight line between their neighbours. Rings are kept closed and an error is returned if a ring collapses.
+// geojson can be a FeatureCollection, Feature, GeometryCollection, Geometry or any geometry type
+// and the returned object has the same type.
+//
+// Examples:
+//
+//	res, err := mutation.CleanCoords(&geometry.LineString{...}, CleanCoordsOptions{})
+//	ln := res.(*geometry.LineString)
+func CleanCoords(geojson interface{}, options CleanCoordsOptions) (interface{}, error) {
+	if geojson == nil {
+		return nil, errors.New("geojson is required")
+	}
+
+	return common.EachGeometry(geojson, options.Mutate, cleanGeometry)
+}
+
+// cleanGeometry cleans the raw coordinates of the geometry, so that the altitudes are kept, and replaces them.
+// Positions are compared by their longitude and latitude.
+func cleanGeometry(g *geometry.Geometry) error {
+	switch g.GeoJSONType {
+	case geojson.MultiPoint:
+		pts, err := common.RawLine(g.Coordinates)
+		if err != nil {
+			return err
+		}
+		res, err := cleanMultiPoint(pts)
+		if err != nil {
+			return err
+		}
+		g.Coordinates = res
+	case geojson.LineString, geojson.MultiLineString:
+		return common.EachLine(g, cleanLine)
+	case geojson.Polygon, geojson.MultiPolygon:
+		return common.EachLine(g, cleanRing)
+	}
+	return nil
+}
+
+func cleanMultiPoint(pts [][]float64) ([][]float64, error) {
+	res := [][]float64{}
+	seen := map[[2]float64]bool{}
+	for _, p := range pts {
+		if len(p) < 2 {
+			return nil, errors.New("a position must have at least two coordinates")
+		}
+		if key := [2]float64{p[0], p[1]}; !seen[key] {
+			seen[key] = true
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
+
+// cleanLine removes the repeated positions and the positions that lie between their neighbours.
+func cleanLine(pts [][]float64) ([][]float64, error) {
+	res := [][]float64{}
+	for _, p := range pts {
+		if len(p) < 2 {
+			return nil, errors.New("a position must have at least two coordinates")
+		}
+		if len(res) > 0 && samePosition(res[len(res)-1], p) {
+			continue
+		}
+		res = append(res, p)
+		for len(res) > 2 && isPointOnLineSegment(res[len(res)-3], res[len(res)-1], res[len(res)-2]) {
+			res = append(res[:len(res)-2], res[len(res)-1])
+		}
+	}
+	return res, nil
+}
+
+// cleanRing cleans the ring like a line and removes the closing position as well if it is redundant.
+func cleanRing(pts [][]float64) ([][]float64, error) {
+	ring, err := cleanLine(pts)
+	if err != nil {
+		return nil, err
+	}
+	// the closing position can be redundant as well, and the ring is cleaned again with the new one until it stops changing
+	for len(ring) > 3 && samePosition(ring[0], ring[len(ring)-1]) && isPointOnLineSegment(ring[len(ring)-2], ring[1], ring[0]) {
+		if ring, err = cleanLine(append(ring[1:len(ring)-1], ring[1])); err != nil {
+			return nil, err
+		}
+	}
+	if len(ring) < 4 {
+		return nil, errors.New("invalid polygon")
+	}
+	return ring, nil
+}
+
+func samePosition(p1 []float64, p2 []float64) bool {
+	return p1[0] == p2[0] && p1[1] == p2[1]
+}
+
+// isPointOnLineSegment checks if the point lies on the segment between start and end.
+func isPointOnLineSegment(start []float64, end []float64, p []float64) bool {
+	cross := (p[0]-start[0])*(end[1]-start[1]) - (p[1]-start[1])*(end[0]-start[0])
+	if cross != 0 {
+		return false
+	}
+	return p[0] >= math.Min(start[0], end[0]) && p[0] <= math.Max(start[0], end[0]) &&
+		p[1] >= math.Min(start[1], end[1]) && p[1] <= math.Max(start[1], end[1])
+}
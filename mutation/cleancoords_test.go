@@ -0,0 +1,101 @@
+package mutation
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+)
+
+func TestCleanCoordsLineString(t *testing.T) {
+	ln := &geometry.LineString{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0},
+		{Lng: 0, Lat: 2},
+		{Lng: 0, Lat: 5},
+		{Lng: 0, Lat: 5},
+		{Lng: 0, Lat: 8},
+		{Lng: 5, Lat: 8},
+	}}
+	res, err := CleanCoords(ln, CleanCoordsOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, res.(*geometry.LineString).Coordinates, []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0, Lat: 8}, {Lng: 5, Lat: 8}})
+	// the input isn't changed
+	assert.Equal(t, len(ln.Coordinates), 6)
+}
+
+func TestCleanCoordsPolygon(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 5], [0, 0], [5, 0], [10, 0], [10, 10], [10, 10], [0, 10], [0, 5]]] } }")
+	assert.Nil(t, err)
+	res, err := CleanCoords(f, CleanCoordsOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, res.(*feature.Feature).Geometry.Coordinates, [][][]float64{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}})
+}
+
+func TestCleanCoordsPolygonCollinearStart(t *testing.T) {
+	// the first two positions lie on the bottom edge
+	poly := &geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 2, Lat: 0}, {Lng: 5, Lat: 0}, {Lng: 10, Lat: 0}, {Lng: 10, Lat: 10}, {Lng: 0, Lat: 10}, {Lng: 0, Lat: 0}, {Lng: 2, Lat: 0},
+	}}}}
+	res, err := CleanCoords(poly, CleanCoordsOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, res.(*geometry.Polygon).Coordinates[0].Coordinates, []geometry.Point{
+		{Lng: 10, Lat: 0}, {Lng: 10, Lat: 10}, {Lng: 0, Lat: 10}, {Lng: 0, Lat: 0}, {Lng: 10, Lat: 0},
+	})
+}
+
+func TestCleanCoordsAltitude(t *testing.T) {
+	tests := map[string]struct {
+		geojson string
+		want    interface{}
+	}{
+		"line": {
+			geojson: "{ \"type\": \"LineString\", \"coordinates\": [[0, 0, 10], [0, 2, 20], [0, 2, 20], [0, 5, 30], [5, 5, 40]] }",
+			want:    [][]float64{{0, 0, 10}, {0, 5, 30}, {5, 5, 40}},
+		},
+		"polygon": {
+			geojson: "{ \"type\": \"Polygon\", \"coordinates\": [[[0, 5, 1], [0, 0, 2], [5, 0, 3], [10, 0, 4], [10, 10, 5], [0, 10, 6], [0, 5, 1]]] }",
+			want:    [][][]float64{{{0, 0, 2}, {10, 0, 4}, {10, 10, 5}, {0, 10, 6}, {0, 0, 2}}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := geometry.FromJSON(tt.geojson)
+			assert.Nil(t, err)
+			res, err := CleanCoords(g, CleanCoordsOptions{})
+			assert.Nil(t, err)
+			assert.Equal(t, res.(*geometry.Geometry).Coordinates, tt.want)
+		})
+	}
+}
+
+func TestCleanCoordsInvalidPolygon(t *testing.T) {
+	poly := &geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: 0},
+	}}}}
+	_, err := CleanCoords(poly, CleanCoordsOptions{})
+	assert.Equal(t, err.Error(), "invalid polygon")
+}
+
+func TestCleanCoordsGeometryCollection(t *testing.T) {
+	gc, err := geometry.CollectionFromJSON("{ \"type\": \"GeometryCollection\", \"geometries\": [" +
+		"{ \"type\": \"MultiPoint\", \"coordinates\": [[0, 0], [0, 0], [1, 1]] }," +
+		"{ \"type\": \"LineString\", \"coordinates\": [[0, 0], [1, 1], [2, 2]] }" +
+		"] }")
+	assert.Nil(t, err)
+	res, err := CleanCoords(gc, CleanCoordsOptions{Mutate: true})
+	assert.Nil(t, err)
+	assert.True(t, res.(*geometry.Collection) == gc)
+	assert.Equal(t, gc.Geometries[0].Coordinates, [][]float64{{0, 0}, {1, 1}})
+	assert.Equal(t, gc.Geometries[1].Coordinates, [][]float64{{0, 0}, {2, 2}})
+}
+
+func TestCleanCoordsMultiLineStringMutate(t *testing.T) {
+	ml := &geometry.MultiLineString{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 2, Lat: 2},
+	}}}}
+	res, err := CleanCoords(ml, CleanCoordsOptions{Mutate: true})
+	assert.Nil(t, err)
+	assert.True(t, res.(*geometry.MultiLineString) == ml)
+	assert.Equal(t, ml.Coordinates[0].Coordinates, []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 2}})
+}
@@ -0,0 +1,120 @@
+package mutation
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/booleans"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+// RewindOptions ...
+type RewindOptions struct {
+	// Reverse enables the reverse winding, outer rings clockwise and holes counter-clockwise. false is the default value
+	Reverse bool
+	// Mutate allows the input GeoJSON to be changed in place, otherwise a clone is rewound. false is the default value
+	Mutate bool
+}
+
+// Rewind rewinds the rings of (Multi)Polygons to follow the right-hand rule of RFC 7946,
+// outer rings counter-clockwise and holes clockwise. LineStrings are rewound clockwise.
+// geojson can be a FeatureCollection, Feature, GeometryCollection, Geometry or any geometry type
+// and the returned object has the same type.
+//
+// Examples:
+//
+//	res, err := mutation.Rewind(fc, RewindOptions{})
+//	rewound := res.(*feature.Collection)
+func Rewind(geojson interface{}, options RewindOptions) (interface{}, error) {
+	if geojson == nil {
+		return nil, errors.New("geojson is required")
+	}
+
+	return common.EachGeometry(geojson, options.Mutate, func(g *geometry.Geometry) error {
+		return rewindGeometry(g, options.Reverse)
+	})
+}
+
+// rewindGeometry rewinds the raw coordinates of the geometry, so that the altitudes are kept, and replaces them
+// if a ring was reversed. Geometries without rings are left untouched.
+func rewindGeometry(g *geometry.Geometry, reverse bool) error {
+	switch g.GeoJSONType {
+	case geojson.LineString:
+		ln, err := common.RawLine(g.Coordinates)
+		if err != nil {
+			return err
+		}
+		cw, err := clockwise(ln)
+		if err != nil {
+			return err
+		}
+		if cw == reverse {
+			reverseCoords(ln)
+			g.Coordinates = ln
+		}
+	case geojson.Polygon:
+		poly, err := common.RawPolygon(g.Coordinates)
+		if err != nil {
+			return err
+		}
+		changed, err := rewindPolygon(poly, reverse)
+		if err != nil {
+			return err
+		}
+		if changed {
+			g.Coordinates = poly
+		}
+	case geojson.MultiPolygon:
+		mp, err := common.RawMultiPolygon(g.Coordinates)
+		if err != nil {
+			return err
+		}
+		changed := false
+		for _, p := range mp {
+			c, err := rewindPolygon(p, reverse)
+			if err != nil {
+				return err
+			}
+			changed = changed || c
+		}
+		if changed {
+			g.Coordinates = mp
+		}
+	}
+	return nil
+}
+
+// rewindPolygon reverses the rings that are wound the wrong way and reports whether any was reversed.
+func rewindPolygon(rings [][][]float64, reverse bool) (bool, error) {
+	changed := false
+	for i, r := range rings {
+		cw, err := clockwise(r)
+		if err != nil {
+			return false, err
+		}
+		// the outer ring is counter-clockwise and the holes are clockwise
+		if (i == 0 && cw != reverse) || (i > 0 && cw == reverse) {
+			reverseCoords(r)
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+func clockwise(coords [][]float64) (bool, error) {
+	pts := []geometry.Point{}
+	for _, c := range coords {
+		if len(c) < 2 {
+			return false, errors.New("a position must have at least two coordinates")
+		}
+		pts = append(pts, geometry.Point{Lng: c[0], Lat: c[1]})
+	}
+	return booleans.Clockwise(pts)
+}
+
+func reverseCoords(coords [][]float64) {
+	for i, j := 0, len(coords)-1; i < j; i, j = i+1, j-1 {
+		coords[i], coords[j] = coords[j], coords[i]
+	}
+}
@@ -0,0 +1,119 @@
+package mutation
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/booleans"
+)
+
+const polygonWithHole = "{ \"type\": \"Feature\", \"properties\": { \"name\": \"p\" }, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0, 0], [0, 10], [10, 10], [10, 0], [0, 0]], [[2, 2], [4, 2], [4, 4], [2, 4], [2, 2]]] } }"
+
+func orientation(t *testing.T, p *geometry.Polygon) []bool {
+	res := []bool{}
+	for _, r := range p.Coordinates {
+		cw, err := booleans.Clockwise(r.Coordinates)
+		assert.Nil(t, err)
+		res = append(res, cw)
+	}
+	return res
+}
+
+func TestRewindFeature(t *testing.T) {
+	f, err := feature.FromJSON(polygonWithHole)
+	assert.Nil(t, err)
+
+	res, err := Rewind(f, RewindOptions{})
+	assert.Nil(t, err)
+	rewound := res.(*feature.Feature)
+	poly, err := rewound.Geometry.ToPolygon()
+	assert.Nil(t, err)
+	assert.Equal(t, orientation(t, poly), []bool{false, true})
+	assert.Equal(t, rewound.Properties["name"], "p")
+
+	// the input is cloned by default
+	original, err := f.Geometry.ToPolygon()
+	assert.Nil(t, err)
+	assert.Equal(t, orientation(t, original), []bool{true, false})
+}
+
+func TestRewindReverse(t *testing.T) {
+	f, err := feature.FromJSON(polygonWithHole)
+	assert.Nil(t, err)
+
+	res, err := Rewind(f, RewindOptions{Reverse: true})
+	assert.Nil(t, err)
+	poly, err := res.(*feature.Feature).Geometry.ToPolygon()
+	assert.Nil(t, err)
+	assert.Equal(t, orientation(t, poly), []bool{true, false})
+}
+
+func TestRewindAltitude(t *testing.T) {
+	tests := map[string]struct {
+		coords [][][]float64
+		want   [][][]float64
+	}{
+		"rewound": {
+			coords: [][][]float64{{{0, 0, 5}, {0, 1, 6}, {1, 1, 7}, {1, 0, 8}, {0, 0, 5}}},
+			want:   [][][]float64{{{0, 0, 5}, {1, 0, 8}, {1, 1, 7}, {0, 1, 6}, {0, 0, 5}}},
+		},
+		"already wound": {
+			coords: [][][]float64{{{0, 0, 5}, {1, 0, 8}, {1, 1, 7}, {0, 1, 6}, {0, 0, 5}}},
+			want:   [][][]float64{{{0, 0, 5}, {1, 0, 8}, {1, 1, 7}, {0, 1, 6}, {0, 0, 5}}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := &geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: tt.coords}
+			res, err := Rewind(g, RewindOptions{})
+			assert.Nil(t, err)
+			assert.Equal(t, res.(*geometry.Geometry).Coordinates, tt.want)
+		})
+	}
+}
+
+func TestRewindMutate(t *testing.T) {
+	poly := &geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 0, Lat: 1}, {Lng: 1, Lat: 1}, {Lng: 1, Lat: 0}, {Lng: 0, Lat: 0},
+	}}}}
+	res, err := Rewind(poly, RewindOptions{Mutate: true})
+	assert.Nil(t, err)
+	assert.True(t, res.(*geometry.Polygon) == poly)
+	assert.Equal(t, orientation(t, poly), []bool{false})
+}
+
+func TestRewindFeatureCollection(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"MultiPolygon\", \"coordinates\": [[[[0, 0], [0, 1], [1, 1], [1, 0], [0, 0]]], [[[5, 5], [6, 5], [6, 6], [5, 6], [5, 5]]]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Point\", \"coordinates\": [1, 1] } }" +
+		"] }")
+	assert.Nil(t, err)
+
+	res, err := Rewind(fc, RewindOptions{})
+	assert.Nil(t, err)
+	rewound := res.(*feature.Collection)
+	mp, err := rewound.Features[0].Geometry.ToMultiPolygon()
+	assert.Nil(t, err)
+	for _, p := range mp.Coordinates {
+		p := p
+		assert.Equal(t, orientation(t, &p), []bool{false})
+	}
+	assert.Equal(t, rewound.Features[1].Geometry.Coordinates, fc.Features[1].Geometry.Coordinates)
+}
+
+func TestRewindLineString(t *testing.T) {
+	ln := &geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 0, Lat: 0}}}
+	res, err := Rewind(ln, RewindOptions{})
+	assert.Nil(t, err)
+	cw, err := booleans.Clockwise(res)
+	assert.Nil(t, err)
+	assert.True(t, cw)
+}
+
+func TestRewindEmptyGeoJSON(t *testing.T) {
+	_, err := Rewind(nil, RewindOptions{})
+	assert.Equal(t, err.Error(), "geojson is required")
+}
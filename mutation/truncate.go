@@ -0,0 +1,61 @@
+package mutation
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+// TruncateOptions ...
+type TruncateOptions struct {
+	// Precision is the number of decimal places of the coordinates. 6 is the default value
+	Precision *int
+	// Coordinates is the maximum number of coordinates of a position, 2 drops the altitude. 3 is the default value
+	Coordinates *int
+	// Mutate allows the input GeoJSON to be changed in place, otherwise a clone is truncated. false is the default value
+	Mutate bool
+}
+
+// Truncate takes a GeoJSON object and truncates the precision of its coordinates.
+// geojson can be a FeatureCollection, Feature, GeometryCollection, Geometry or any geometry type
+// and the returned object has the same type. The altitude of a position is only available
+// for Features and Geometries, as the geometry types hold longitude and latitude only.
+//
+// Examples:
+//
+//	res, err := mutation.Truncate(f, TruncateOptions{Precision: common.IntPtr(3), Coordinates: common.IntPtr(2)})
+func Truncate(geojson interface{}, options TruncateOptions) (interface{}, error) {
+	if geojson == nil {
+		return nil, errors.New("geojson is required")
+	}
+	if options.Precision == nil {
+		options.Precision = common.IntPtr(6)
+	}
+	if options.Coordinates == nil {
+		options.Coordinates = common.IntPtr(3)
+	}
+	if *options.Precision < 0 {
+		return nil, errors.New("precision must be a non-negative number")
+	}
+	if *options.Coordinates < 2 {
+		return nil, errors.New("coordinates must be at least 2")
+	}
+
+	factor := math.Pow(10, float64(*options.Precision))
+	truncate := func(pos []float64) []float64 {
+		if len(pos) > *options.Coordinates {
+			pos = pos[:*options.Coordinates]
+		}
+		res := make([]float64, len(pos))
+		for i, v := range pos {
+			res[i] = math.Round(v*factor) / factor
+		}
+		return res
+	}
+
+	return common.EachGeometry(geojson, options.Mutate, func(g *geometry.Geometry) error {
+		return common.EachPosition(g, truncate)
+	})
+}
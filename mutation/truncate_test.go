@@ -0,0 +1,60 @@
+package mutation
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+func TestTruncatePoint(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Point\", \"coordinates\": [70.46923055566859, 58.11088890802906, 1508] } }")
+	assert.Nil(t, err)
+
+	res, err := Truncate(f, TruncateOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, res.(*feature.Feature).Geometry.Coordinates, []float64{70.469231, 58.110889, 1508})
+
+	res, err = Truncate(f, TruncateOptions{Precision: common.IntPtr(3), Coordinates: common.IntPtr(2)})
+	assert.Nil(t, err)
+	assert.Equal(t, res.(*feature.Feature).Geometry.Coordinates, []float64{70.469, 58.111})
+
+	// the input isn't changed
+	assert.Equal(t, f.Geometry.Coordinates, []interface{}{70.46923055566859, 58.11088890802906, 1508.0})
+}
+
+func TestTruncateFeatureCollection(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[0.123456789, 0], [1, 0], [1, 1.987654321], [0.123456789, 0]]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"MultiLineString\", \"coordinates\": [[[0.55, 0.55, 10.55], [1, 1]], [[2, 2], [3.33, 3.33]]] } }" +
+		"] }")
+	assert.Nil(t, err)
+
+	res, err := Truncate(fc, TruncateOptions{Precision: common.IntPtr(1), Mutate: true})
+	assert.Nil(t, err)
+	assert.True(t, res.(*feature.Collection) == fc)
+	assert.Equal(t, fc.Features[0].Geometry.Coordinates, [][][]float64{{{0.1, 0}, {1, 0}, {1, 2}, {0.1, 0}}})
+	assert.Equal(t, fc.Features[1].Geometry.Coordinates, [][][]float64{{{0.6, 0.6, 10.6}, {1, 1}}, {{2, 2}, {3.3, 3.3}}})
+}
+
+func TestTruncateGeometry(t *testing.T) {
+	ln := &geometry.LineString{Coordinates: []geometry.Point{{Lng: 0.123456789, Lat: 1.123456789}, {Lng: 2, Lat: 3}}}
+	res, err := Truncate(ln, TruncateOptions{Precision: common.IntPtr(2)})
+	assert.Nil(t, err)
+	assert.Equal(t, res.(*geometry.LineString).Coordinates, []geometry.Point{{Lng: 0.12, Lat: 1.12}, {Lng: 2, Lat: 3}})
+	assert.Equal(t, ln.Coordinates[0], geometry.Point{Lng: 0.123456789, Lat: 1.123456789})
+}
+
+func TestTruncateInvalidOptions(t *testing.T) {
+	_, err := Truncate(&geometry.Point{}, TruncateOptions{Coordinates: common.IntPtr(1)})
+	assert.Equal(t, err.Error(), "coordinates must be at least 2")
+	_, err = Truncate(&geometry.Point{}, TruncateOptions{Precision: common.IntPtr(-1)})
+	assert.Equal(t, err.Error(), "precision must be a non-negative number")
+
+	// a precision of 0 rounds to whole numbers
+	res, err := Truncate(&geometry.Point{Lng: 1.6, Lat: 2.4}, TruncateOptions{Precision: common.IntPtr(0)})
+	assert.Nil(t, err)
+	assert.Equal(t, *res.(*geometry.Point), geometry.Point{Lng: 2, Lat: 2})
+}
@@ -0,0 +1,178 @@
+package random
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	turf "github.com/tomchavakis/turf-go"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/conversions"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// maxRejections is how many positions in a row can be rejected before PointsInPolygon gives up.
+const maxRejections = 100000
+
+// PointsInPolygonOptions ...
+type PointsInPolygonOptions struct {
+	// MinDistance is the shortest distance between any two points. The points are placed with Poisson-disk
+	// sampling when it is set, otherwise they are independent. nil is the default value
+	MinDistance *float64
+	// Units of the min distance. constants.UnitKilometers is the default value
+	Units string
+}
+
+// PointsInPolygon returns a GeoJSON FeatureCollection of random Point within a Polygon or MultiPolygon, outside of its holes.
+// The points are uniformly distributed over the surface of the sphere, so areas near the poles, which span more degrees
+// of longitude, get no more points than areas of the same size near the equator.
+//
+// count is how many points will be generated. default = 1
+//
+// Examples:
+//
+//	random.PointsInPolygon(100, serviceArea, random.PointsInPolygonOptions{MinDistance: common.Float64Ptr(500), Units: constants.UnitMeters})
+func PointsInPolygon(count int, polygon interface{}, options PointsInPolygonOptions) (*feature.Collection, error) {
+	return global.PointsInPolygon(count, polygon, options)
+}
+
+// PointsInPolygon returns a GeoJSON FeatureCollection of random Point within a Polygon or MultiPolygon, outside of its holes.
+func (g *Generator) PointsInPolygon(count int, polygon interface{}, options PointsInPolygonOptions) (*feature.Collection, error) {
+	if count == 0 {
+		count = 1
+	}
+	if options.Units == "" {
+		options.Units = constants.UnitKilometers
+	}
+	if options.MinDistance != nil && *options.MinDistance <= 0 {
+		return nil, errors.New("min distance must be a positive number")
+	}
+	polys, err := common.Polygons(polygon)
+	if err != nil {
+		return nil, err
+	}
+
+	// every polygon is picked in proportion to its area
+	areas := make([]float64, len(polys))
+	total := 0.0
+	for i := range polys {
+		a, err := measurement.Area(&polys[i])
+		if err != nil {
+			return nil, err
+		}
+		total += a
+		areas[i] = total
+	}
+	if total == 0 {
+		return nil, errors.New("polygon must have an area")
+	}
+
+	var index *spacing
+	if options.MinDistance != nil {
+		degrees, err := conversions.LengthToDegrees(*options.MinDistance, options.Units)
+		if err != nil {
+			return nil, err
+		}
+		index = &spacing{cells: map[[2]int64][]geometry.Point{}, cellSize: degrees, distance: *options.MinDistance, units: options.Units}
+	}
+
+	fc := []feature.Feature{}
+	for rejections := 0; len(fc) < count; {
+		if rejections == maxRejections {
+			return nil, errors.New("can't generate the random points in the polygon")
+		}
+
+		i, a := 0, g.float64()*total
+		for areas[i] <= a && i < len(polys)-1 {
+			i++
+		}
+		p, err := g.positionInPolygon(polys[i])
+		if err != nil {
+			return nil, err
+		}
+		if index != nil {
+			added, err := index.add(p)
+			if err != nil {
+				return nil, err
+			}
+			if !added {
+				rejections++
+				continue
+			}
+		}
+		rejections = 0
+
+		f, err := feature.New(geometry.Geometry{GeoJSONType: geojson.Point, Coordinates: common.PointCoords(p)}, nil, nil, "")
+		if err != nil {
+			return nil, err
+		}
+		fc = append(fc, *f)
+	}
+	return feature.NewFeatureCollection(fc)
+}
+
+// positionInPolygon returns a position within the polygon, outside of its holes. The positions rejected by the polygon
+// are drawn again within the same polygon, so that every polygon gets points in proportion to its area rather than
+// to the share of its bbox it covers.
+func (g *Generator) positionInPolygon(p geometry.Polygon) (geometry.Point, error) {
+	for i := 0; i < maxRejections; i++ {
+		pos := g.positionOnSphere(p)
+		in, err := turf.PointInPolygon(pos, p)
+		if err != nil {
+			return geometry.Point{}, err
+		}
+		if in {
+			return pos, nil
+		}
+	}
+	return geometry.Point{}, errors.New("can't generate the random points in the polygon")
+}
+
+// positionOnSphere returns a position within the bbox of the outer ring of the polygon, uniformly distributed
+// over the surface of the sphere: the sine of the latitude is uniform rather than the latitude itself.
+func (g *Generator) positionOnSphere(p geometry.Polygon) geometry.Point {
+	west, south, east, north := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, c := range p.Coordinates[0].Coordinates {
+		west, south = math.Min(west, c.Lng), math.Min(south, c.Lat)
+		east, north = math.Max(east, c.Lng), math.Max(north, c.Lat)
+	}
+	lng := west + g.float64()*(east-west)
+	s, n := math.Sin(conversions.DegreesToRadians(south)), math.Sin(conversions.DegreesToRadians(north))
+	lat := conversions.RadiansToDegrees(math.Asin(s + g.float64()*(n-s)))
+	return geometry.Point{Lng: lng, Lat: lat}
+}
+
+// spacing indexes the accepted points in cells of the min distance, so that only the points in the neighbouring cells
+// are measured.
+type spacing struct {
+	cells    map[[2]int64][]geometry.Point
+	cellSize float64
+	distance float64
+	units    string
+}
+
+// add adds the point if it is not closer than the min distance to any of the points, and reports whether it was added.
+func (s *spacing) add(p geometry.Point) (bool, error) {
+	key := [2]int64{int64(math.Floor(p.Lng / s.cellSize)), int64(math.Floor(p.Lat / s.cellSize))}
+	// a degree of longitude is shorter than a degree of latitude away from the equator
+	lat := math.Min(math.Abs(p.Lat)+s.cellSize, 90)
+	span := int64(math.Ceil(1 / math.Max(math.Cos(conversions.DegreesToRadians(lat)), 1e-6)))
+	for y := key[1] - 1; y <= key[1]+1; y++ {
+		for x := key[0] - span; x <= key[0]+span; x++ {
+			for _, q := range s.cells[[2]int64{x, y}] {
+				d, err := measurement.PointDistance(p, q, s.units)
+				if err != nil {
+					return false, err
+				}
+				if d < s.distance {
+					return false, nil
+				}
+			}
+		}
+	}
+	s.cells[key] = append(s.cells[key], p)
+	return true, nil
+}
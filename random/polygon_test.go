@@ -0,0 +1,145 @@
+package random
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+func square(west float64, south float64, east float64, north float64) geometry.LineString {
+	return geometry.LineString{Coordinates: []geometry.Point{
+		{Lng: west, Lat: south}, {Lng: east, Lat: south}, {Lng: east, Lat: north}, {Lng: west, Lat: north}, {Lng: west, Lat: south},
+	}}
+}
+
+func points(t *testing.T, fc *feature.Collection) []geometry.Point {
+	pts := []geometry.Point{}
+	for _, f := range fc.Features {
+		p, err := f.ToPoint()
+		assert.Nil(t, err)
+		pts = append(pts, *p)
+	}
+	return pts
+}
+
+func TestPointsInPolygonWithHole(t *testing.T) {
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{square(0, 0, 10, 10), square(2, 2, 8, 8)}}
+	fc, err := NewGenerator(1).PointsInPolygon(500, &poly, PointsInPolygonOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, len(fc.Features), 500)
+	for _, p := range points(t, fc) {
+		in, err := turf.PointInPolygon(p, poly)
+		assert.Nil(t, err)
+		assert.True(t, in)
+	}
+
+	fc, err = PointsInPolygon(0, &poly, PointsInPolygonOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, len(fc.Features), 1)
+}
+
+func TestPointsInPolygonUniformOnSphere(t *testing.T) {
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{square(0, 0, 10, 80)}}
+	fc, err := NewGenerator(2).PointsInPolygon(4000, &poly, PointsInPolygonOptions{})
+	assert.Nil(t, err)
+
+	// the area above 60 degrees is (sin 80 - sin 60) / sin 80 = 12% of the polygon, not the 25% of its degrees
+	north := 0
+	for _, p := range points(t, fc) {
+		if p.Lat >= 60 {
+			north++
+		}
+	}
+	share := float64(north) / 4000
+	assert.True(t, share > 0.10 && share < 0.14)
+}
+
+func TestPointsInMultiPolygon(t *testing.T) {
+	tests := map[string]struct {
+		second geometry.LineString
+		share  float64
+	}{
+		"larger square": {
+			// the second polygon is four times larger
+			second: square(50, 0, 52, 2),
+			share:  0.8,
+		},
+		"triangle": {
+			// the triangle has the same area as the square but covers half of its bbox
+			second: geometry.LineString{Coordinates: []geometry.Point{{Lng: 50, Lat: 0}, {Lng: 52, Lat: 0}, {Lng: 50, Lat: 1}, {Lng: 50, Lat: 0}}},
+			share:  0.5,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mp := geometry.MultiPolygon{Coordinates: []geometry.Polygon{
+				{Coordinates: []geometry.LineString{square(0, 0, 1, 1)}},
+				{Coordinates: []geometry.LineString{tt.second}},
+			}}
+			fc, err := NewGenerator(3).PointsInPolygon(2000, &mp, PointsInPolygonOptions{})
+			assert.Nil(t, err)
+
+			second := 0
+			for _, p := range points(t, fc) {
+				assert.True(t, turf.PointInMultiPolygon(p, mp))
+				if p.Lng >= 50 {
+					second++
+				}
+			}
+			share := float64(second) / 2000
+			assert.True(t, share > tt.share-0.03 && share < tt.share+0.03)
+		})
+	}
+}
+
+func TestPointsInPolygonPoissonDisk(t *testing.T) {
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{square(0, 50, 10, 60)}}
+	fc, err := NewGenerator(4).PointsInPolygon(200, &poly, PointsInPolygonOptions{MinDistance: common.Float64Ptr(30), Units: constants.UnitKilometers})
+	assert.Nil(t, err)
+	assert.Equal(t, len(fc.Features), 200)
+
+	pts := points(t, fc)
+	for i := range pts {
+		for j := i + 1; j < len(pts); j++ {
+			d, err := measurement.PointDistance(pts[i], pts[j], constants.UnitKilometers)
+			assert.Nil(t, err)
+			assert.True(t, d >= 30)
+		}
+	}
+}
+
+func TestPointsInPolygonErrors(t *testing.T) {
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{square(0, 0, 1, 1)}}
+	tests := map[string]struct {
+		polygon interface{}
+		options PointsInPolygonOptions
+		err     string
+	}{
+		"not a polygon": {
+			polygon: &geometry.Point{},
+			err:     "geometry must be a Polygon or a MultiPolygon",
+		},
+		"invalid min distance": {
+			polygon: &poly,
+			options: PointsInPolygonOptions{MinDistance: common.Float64Ptr(0)},
+			err:     "min distance must be a positive number",
+		},
+		"too close": {
+			polygon: &poly,
+			options: PointsInPolygonOptions{MinDistance: common.Float64Ptr(100)},
+			err:     "can't generate the random points in the polygon",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewGenerator(5).PointsInPolygon(10, tt.polygon, tt.options)
+			assert.Equal(t, err.Error(), tt.err)
+		})
+	}
+}
@@ -34,6 +34,38 @@ type PolygonOptions struct {
 	MaxRadialLength *float64
 }
 
+// Generator generates random geometries from its own source of random numbers, so that the same seed always
+// generates the same geometries. Its methods have the same options as the package level functions, which use
+// the global source of the math/rand package.
+type Generator struct {
+	r *rand.Rand
+}
+
+var global = &Generator{}
+
+// NewGenerator returns a Generator seeded with the given value.
+//
+// Examples:
+//
+//	g := random.NewGenerator(42)
+//	fc, err := g.Point(10, geojson.BBOX{West: -10, South: -10, East: 10, North: 10})
+func NewGenerator(seed int64) *Generator {
+	return &Generator{r: rand.New(rand.NewSource(seed))}
+}
+
+// NewGeneratorFromRand returns a Generator that draws its numbers from r.
+func NewGeneratorFromRand(r *rand.Rand) *Generator {
+	return &Generator{r: r}
+}
+
+// float64 returns a number in [0.0,1.0) from the source of the generator.
+func (g *Generator) float64() float64 {
+	if g.r == nil {
+		return rand.Float64()
+	}
+	return g.r.Float64()
+}
+
 // Position returns a random position within a bounding box
 //
 // Examples:
@@ -46,7 +78,12 @@ type PolygonOptions struct {
 //			North: 90,
 //		})
 func Position(bbox geojson.BBOX) geometry.Position {
-	pos := coordInBBox(bbox)
+	return global.Position(bbox)
+}
+
+// Position returns a random position within a bounding box.
+func (g *Generator) Position(bbox geojson.BBOX) geometry.Position {
+	pos := g.coordInBBox(bbox)
 	res := geometry.NewPosition(nil, pos[0], pos[1])
 	return *res
 }
@@ -73,19 +110,24 @@ func Position(bbox geojson.BBOX) geometry.Position {
 //			North: 90,
 //		})
 func Point(count int, bbox geojson.BBOX) (*feature.Collection, error) {
+	return global.Point(count, bbox)
+}
+
+// Point returns a GeoJSON FeatureCollection of random Point within a bounding box.
+func (g *Generator) Point(count int, bbox geojson.BBOX) (*feature.Collection, error) {
 	if count == 0 {
 		count = 1
 	}
 	fc := []feature.Feature{}
 
 	for i := 0; i < count; i++ {
-		p := coordInBBox(bbox)
+		p := g.coordInBBox(bbox)
 		coords := []float64{p[0], p[1]}
-		g := geometry.Geometry{
+		geom := geometry.Geometry{
 			GeoJSONType: geojson.Point,
 			Coordinates: coords,
 		}
-		f, err := feature.New(g, []float64{bbox.North, bbox.West, bbox.East, bbox.South}, nil, "")
+		f, err := feature.New(geom, []float64{bbox.North, bbox.West, bbox.East, bbox.South}, nil, "")
 		if err != nil {
 			return nil, fmt.Errorf("cannot create a new Feature with error: %v", err)
 		}
@@ -117,6 +159,11 @@ func Point(count int, bbox geojson.BBOX) (*feature.Collection, error) {
 //			MaxRotation: nil,
 //		})
 func LineString(count int, options LineStringOptions) (*feature.Collection, error) {
+	return global.LineString(count, options)
+}
+
+// LineString returns a GeoJSON FeatureCollection of random LineString within a bounding box.
+func (g *Generator) LineString(count int, options LineStringOptions) (*feature.Collection, error) {
 	if count == 0 {
 		count = 1
 	}
@@ -137,28 +184,28 @@ func LineString(count int, options LineStringOptions) (*feature.Collection, erro
 
 	for i := 0; i < count; i++ {
 
-		startingPoint := Position(options.BBox)
+		startingPoint := g.Position(options.BBox)
 		vertices := [][]float64{}
 		vertices = append(vertices, []float64{startingPoint.ToPoint().Lng, startingPoint.ToPoint().Lat})
 
 		for j := 0; j < *options.NumVertices-1; j++ {
 			var priorAngle float64
 			if j == 0 {
-				priorAngle = rand.Float64() * 2 * math.Pi
+				priorAngle = g.float64() * 2 * math.Pi
 			} else {
 				priorAngle = math.Tan((vertices[j][1] - vertices[j-1][1]) / (vertices[j][0] - vertices[j-1][0]))
 			}
-			angle := priorAngle + (rand.Float64()-0.5)*(*options.MaxRotation)*2
-			distance := rand.Float64() * (*options.MaxLength)
+			angle := priorAngle + (g.float64()-0.5)*(*options.MaxRotation)*2
+			distance := g.float64() * (*options.MaxLength)
 			vv := []float64{vertices[j][0] + distance*math.Cos(angle), vertices[j][1] + distance*math.Sin(angle)}
 			vertices = append(vertices, vv)
 		}
 
-		g := geometry.Geometry{
+		geom := geometry.Geometry{
 			GeoJSONType: geojson.LineString,
 			Coordinates: vertices,
 		}
-		f, err := feature.New(g, []float64{options.BBox.North, options.BBox.West, options.BBox.East, options.BBox.South}, nil, "")
+		f, err := feature.New(geom, []float64{options.BBox.North, options.BBox.West, options.BBox.East, options.BBox.South}, nil, "")
 		if err != nil {
 			return nil, fmt.Errorf("cannot create a new Feature with error: %v", err)
 		}
@@ -189,6 +236,11 @@ func LineString(count int, options LineStringOptions) (*feature.Collection, erro
 //		MaxRadialLength: nil,
 //	})
 func Polygon(count int, options PolygonOptions) (*feature.Collection, error) {
+	return global.Polygon(count, options)
+}
+
+// Polygon returns a GeoJSON FeatureCollection of random Polygon.
+func (g *Generator) Polygon(count int, options PolygonOptions) (*feature.Collection, error) {
 
 	if count == 0 {
 		count = 1
@@ -213,16 +265,16 @@ func Polygon(count int, options PolygonOptions) (*feature.Collection, error) {
 		// sum Offsets
 		for i := 0; i < len(circleOffsets); i++ {
 			if i == 0 {
-				circleOffsets[i] = rand.Float64()
+				circleOffsets[i] = g.float64()
 			} else {
-				circleOffsets[i] = rand.Float64() + circleOffsets[i-1]
+				circleOffsets[i] = g.float64() + circleOffsets[i-1]
 			}
 		}
 
 		// scale offsets
 		for j := 0; j < len(circleOffsets); j++ {
 			cur := (circleOffsets[j] * 2 * math.Pi) / circleOffsets[len(circleOffsets)-1]
-			radialScaler := rand.Float64()
+			radialScaler := g.float64()
 			vertices = append(vertices, []float64{radialScaler * (*options.MaxRadialLength) * math.Sin(cur), radialScaler * (*options.MaxRadialLength) * math.Cos(cur)})
 		}
 
@@ -230,14 +282,14 @@ func Polygon(count int, options PolygonOptions) (*feature.Collection, error) {
 		vertices[len(vertices)-1] = vertices[0]
 
 		// center the polygon around something
-		res := vertexToCoordinate(vertices, options.BBox)
+		res := g.vertexToCoordinate(vertices, options.BBox)
 		vts = append(vts, res)
 
-		g := geometry.Geometry{
+		geom := geometry.Geometry{
 			GeoJSONType: geojson.Polygon,
 			Coordinates: vts,
 		}
-		f, err := feature.New(g, []float64{options.BBox.North, options.BBox.West, options.BBox.East, options.BBox.South}, nil, "")
+		f, err := feature.New(geom, []float64{options.BBox.North, options.BBox.West, options.BBox.East, options.BBox.South}, nil, "")
 		if err != nil {
 			return nil, fmt.Errorf("cannot create a new Feature with error: %v", err)
 		}
@@ -251,9 +303,9 @@ func Polygon(count int, options PolygonOptions) (*feature.Collection, error) {
 	return nil, errors.New("can't generate a random LineString")
 }
 
-func vertexToCoordinate(vtc [][]float64, bbox geojson.BBOX) [][]float64 {
+func (g *Generator) vertexToCoordinate(vtc [][]float64, bbox geojson.BBOX) [][]float64 {
 	res := [][]float64{}
-	p := Position(bbox)
+	p := g.Position(bbox)
 	for i := 0; i < len(vtc); i++ {
 		tmp := []float64{}
 		tmp = append(tmp, vtc[i][0]+p.Longitude, vtc[i][1]+p.Latitude)
@@ -262,10 +314,10 @@ func vertexToCoordinate(vtc [][]float64, bbox geojson.BBOX) [][]float64 {
 	return res
 }
 
-func coordInBBox(bbox geojson.BBOX) []float64 {
+func (g *Generator) coordInBBox(bbox geojson.BBOX) []float64 {
 	res := make([]float64, 2)
-	res[0] = rand.Float64()*(bbox.East-bbox.South) + bbox.South
-	res[1] = rand.Float64()*(bbox.West-bbox.North) + bbox.North
+	res[0] = g.float64()*(bbox.East-bbox.South) + bbox.South
+	res[1] = g.float64()*(bbox.West-bbox.North) + bbox.North
 
 	return res
 }
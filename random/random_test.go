@@ -1,6 +1,8 @@
 package random
 
 import (
+	"math/rand"
+	"reflect"
 	"testing"
 
 	"github.com/tomchavakis/geojson"
@@ -148,6 +150,25 @@ func TestRandomPolygon_Vertices_ShouldBe_NumVerticesPlusOne(t *testing.T) {
 	assert.Equal(t, len(poly.Coordinates[0].Coordinates), *options.NumVertices+1)
 }
 
+func TestGeneratorSeed(t *testing.T) {
+	bbox := *geojson.NewBBox(-20.0, -20.0, 20.0, 20.0)
+	generate := func(g *Generator) []*feature.Collection {
+		points, err := g.Point(5, bbox)
+		assert.Nil(t, err)
+		lines, err := g.LineString(5, LineStringOptions{BBox: bbox})
+		assert.Nil(t, err)
+		polygons, err := g.Polygon(5, PolygonOptions{BBox: bbox})
+		assert.Nil(t, err)
+		return []*feature.Collection{points, lines, polygons}
+	}
+
+	a := generate(NewGenerator(42))
+	assert.Equal(t, a, generate(NewGenerator(42)))
+	assert.Equal(t, a, generate(NewGeneratorFromRand(rand.New(rand.NewSource(42)))))
+	assert.True(t, !reflect.DeepEqual(a, generate(NewGenerator(7))))
+	assert.Equal(t, NewGenerator(1).Position(bbox), NewGenerator(1).Position(bbox))
+}
+
 func checkFeaturesInBBox(t *testing.T, bbox *geojson.BBOX, fc []feature.Feature) {
 	for i := 0; i < len(fc); i++ {
 		ln, err := fc[i].Geometry.ToLineString()
@@ -0,0 +1,357 @@
+package transformation
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// AntimeridianCut splits the LineStrings and Polygons that cross the antimeridian into parts on each side of it,
+// following RFC 7946 section 3.1.9. The latitude where a segment crosses the antimeridian is interpolated along
+// the great circle of the segment. Crossings are detected from longitude jumps greater than 180 degrees as well as
+// from longitudes past ±180, and every part is normalized into [-180, 180].
+// Rings that wind around a pole are closed along the pole before they are cut.
+// t can be a Feature, Geometry, (Multi)LineString or (Multi)Polygon. The result is a MultiLineString or a MultiPolygon,
+// or a LineString or a Polygon when there is a single part, as for a geometry that doesn't cross the antimeridian.
+//
+// Examples:
+//
+//	g, err := transformation.AntimeridianCut(&geometry.LineString{...})
+//	if g.GeoJSONType == geojson.MultiLineString {
+//		ml, err := g.ToMultiLineString()
+//	}
+func AntimeridianCut(t interface{}) (*geometry.Geometry, error) {
+	if lines, err := common.Lines(t); err == nil {
+		parts := []geometry.LineString{}
+		for _, l := range lines {
+			for _, p := range cutLine(l.Coordinates) {
+				parts = append(parts, geometry.LineString{Coordinates: p})
+			}
+		}
+		if len(parts) == 1 {
+			return &geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: common.LineStringCoords(parts[0].Coordinates)}, nil
+		}
+		return &geometry.Geometry{GeoJSONType: geojson.MultiLineString, Coordinates: common.MultiLineStringCoords(parts)}, nil
+	}
+
+	polys, err := polygons(t)
+	if err != nil {
+		return nil, err
+	}
+	parts := []geometry.Polygon{}
+	for _, p := range polys {
+		parts = append(parts, cutPolygon(p)...)
+	}
+	if len(parts) == 1 {
+		return &geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: common.PolygonCoords(parts[0])}, nil
+	}
+	return &geometry.Geometry{GeoJSONType: geojson.MultiPolygon, Coordinates: common.MultiPolygonCoords(parts)}, nil
+}
+
+// AntimeridianUnwrap is the inverse of AntimeridianCut. It makes the longitudes of every line and ring continuous,
+// letting them run past ±180 instead of jumping across the antimeridian, which suits planar computations.
+// The parts of a MultiLineString or a MultiPolygon are moved next to each other and merged where they touch,
+// so the result is a LineString or a Polygon when the parts form a single geometry.
+//
+// Examples:
+//
+//	g, err := transformation.AntimeridianUnwrap(cut)
+//	poly, err := g.ToPolygon()
+func AntimeridianUnwrap(t interface{}) (*geometry.Geometry, error) {
+	if lines, err := common.Lines(t); err == nil {
+		parts := []geometry.LineString{}
+		for _, l := range lines {
+			pts := unwrapPoints(l.Coordinates)
+			if len(parts) == 0 || len(pts) == 0 {
+				parts = append(parts, geometry.LineString{Coordinates: pts})
+				continue
+			}
+			last := parts[len(parts)-1].Coordinates
+			pts = shiftPoints(pts, 360*math.Round((last[len(last)-1].Lng-pts[0].Lng)/360))
+			if last[len(last)-1] == pts[0] {
+				parts[len(parts)-1].Coordinates = append(last, pts[1:]...)
+				continue
+			}
+			parts = append(parts, geometry.LineString{Coordinates: pts})
+		}
+		if len(parts) == 1 {
+			return &geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: common.LineStringCoords(parts[0].Coordinates)}, nil
+		}
+		return &geometry.Geometry{GeoJSONType: geojson.MultiLineString, Coordinates: common.MultiLineStringCoords(parts)}, nil
+	}
+
+	polys, err := polygons(t)
+	if err != nil {
+		return nil, err
+	}
+	placed := []geometry.Polygon{}
+	for _, p := range polys {
+		placed = append(placed, placePolygon(unwrapPolygon(p), placed))
+	}
+	if len(placed) > 1 {
+		placed = planar.Union(placed)
+	}
+	if len(placed) == 1 {
+		return &geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: common.PolygonCoords(placed[0])}, nil
+	}
+	return &geometry.Geometry{GeoJSONType: geojson.MultiPolygon, Coordinates: common.MultiPolygonCoords(placed)}, nil
+}
+
+// polygons unwraps the polygons of t without validating rings that run past ±180.
+func polygons(t interface{}) ([]geometry.Polygon, error) {
+	if f, ok := t.(*feature.Feature); ok {
+		t = &f.Geometry
+	}
+	polys, err := common.RawPolygons(t)
+	if err != nil {
+		return nil, errors.New("geometry must be a LineString, MultiLineString, Polygon or MultiPolygon")
+	}
+	return polys, nil
+}
+
+// cutLine splits the line at every crossing of the antimeridian and normalizes the longitudes of the parts.
+func cutLine(pts []geometry.Point) [][]geometry.Point {
+	u := unwrapPoints(pts)
+	if len(u) < 2 {
+		return [][]geometry.Point{normalizePoints(u)}
+	}
+
+	parts := [][]geometry.Point{}
+	cur := []geometry.Point{u[0]}
+	for i := 1; i < len(u); i++ {
+		a, b := u[i-1], u[i]
+		for _, m := range crossings(a.Lng, b.Lng) {
+			c := geometry.Point{Lng: m, Lat: crossingLat(a, b, m)}
+			cur = append(cur, c)
+			parts = append(parts, cur)
+			cur = []geometry.Point{c}
+		}
+		if cur[len(cur)-1] != b {
+			cur = append(cur, b)
+		}
+	}
+	parts = append(parts, cur)
+
+	res := [][]geometry.Point{}
+	for _, p := range parts {
+		if len(p) < 2 {
+			continue
+		}
+		p = normalizePoints(p)
+		// lines that only touch the antimeridian stay in one part
+		if len(res) > 0 {
+			last := res[len(res)-1]
+			if last[len(last)-1] == p[0] {
+				res[len(res)-1] = append(last, p[1:]...)
+				continue
+			}
+		}
+		res = append(res, p)
+	}
+	return res
+}
+
+// cutPolygon splits the unwrapped polygon along the antimeridians it spans and normalizes the longitudes of the parts.
+func cutPolygon(p geometry.Polygon) []geometry.Polygon {
+	p = unwrapPolygon(p)
+	if len(p.Coordinates) == 0 || len(p.Coordinates[0].Coordinates) == 0 {
+		return []geometry.Polygon{}
+	}
+
+	minLng, minLat, maxLng, maxLat := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for i, r := range p.Coordinates {
+		// add the crossings as vertices so that the rings are cut at their great circle latitude
+		pts := []geometry.Point{}
+		for j, c := range r.Coordinates {
+			if j > 0 {
+				for _, m := range crossings(r.Coordinates[j-1].Lng, c.Lng) {
+					pts = append(pts, geometry.Point{Lng: m, Lat: crossingLat(r.Coordinates[j-1], c, m)})
+				}
+			}
+			if len(pts) == 0 || pts[len(pts)-1] != c {
+				pts = append(pts, c)
+			}
+			minLng, minLat = math.Min(minLng, c.Lng), math.Min(minLat, c.Lat)
+			maxLng, maxLat = math.Max(maxLng, c.Lng), math.Max(maxLat, c.Lat)
+		}
+		p.Coordinates[i].Coordinates = pts
+	}
+
+	first, last := strip(minLng), strip(maxLng)
+	if maxLng == -180+360*float64(last) {
+		last--
+	}
+	if first >= last {
+		return []geometry.Polygon{*shiftPolygon(p, -360*float64(first))}
+	}
+
+	segs := planar.PolygonSegments([]geometry.Polygon{p})
+	for k := first; k < last; k++ {
+		m := 180 + 360*float64(k)
+		segs = append(segs, planar.Segment{A: geometry.Point{Lng: m, Lat: minLat - 1}, B: geometry.Point{Lng: m, Lat: maxLat + 1}})
+	}
+	g := planar.Node(segs)
+
+	res := []geometry.Polygon{}
+	for k := first; k <= last; k++ {
+		lo, hi := -180+360*float64(k), 180+360*float64(k)
+		for _, part := range g.Overlay(func(pt geometry.Point) bool {
+			return pt.Lng > lo && pt.Lng < hi && planar.InPolygon(pt, p)
+		}) {
+			res = append(res, *shiftPolygon(part, -360*float64(k)))
+		}
+	}
+	return res
+}
+
+// unwrapPolygon unwraps the rings of the polygon and moves the holes next to the outer ring.
+func unwrapPolygon(p geometry.Polygon) geometry.Polygon {
+	res := geometry.Polygon{}
+	for i, r := range p.Coordinates {
+		pts := unwrapRing(r.Coordinates)
+		if i > 0 && len(pts) > 0 && len(res.Coordinates[0].Coordinates) > 0 {
+			pts = shiftPoints(pts, 360*math.Round((res.Coordinates[0].Coordinates[0].Lng-pts[0].Lng)/360))
+		}
+		res.Coordinates = append(res.Coordinates, geometry.LineString{Coordinates: pts})
+	}
+	return res
+}
+
+// unwrapRing unwraps the ring and closes the rings that wind around a pole along the pole.
+func unwrapRing(ring []geometry.Point) []geometry.Point {
+	u := unwrapPoints(ring)
+	n := len(u)
+	if n < 2 || math.Abs(u[n-1].Lng-u[0].Lng) < 180 {
+		return u
+	}
+
+	lat := 0.0
+	for _, c := range u {
+		lat += c.Lat
+	}
+	pole := 90.0
+	if lat < 0 {
+		pole = -90
+	}
+	return append(u, geometry.Point{Lng: u[n-1].Lng, Lat: pole}, geometry.Point{Lng: u[0].Lng, Lat: pole}, u[0])
+}
+
+// unwrapPoints removes the longitude jumps greater than 180 degrees between consecutive points.
+func unwrapPoints(pts []geometry.Point) []geometry.Point {
+	res := make([]geometry.Point, len(pts))
+	for i, p := range pts {
+		if i > 0 {
+			p.Lng += 360 * math.Round((res[i-1].Lng-p.Lng)/360)
+		}
+		res[i] = p
+	}
+	return res
+}
+
+// placePolygon moves the polygon by whole turns next to the polygons placed before it,
+// preferring the position where it shares the most vertices with them.
+func placePolygon(p geometry.Polygon, placed []geometry.Polygon) geometry.Polygon {
+	if len(placed) == 0 || len(p.Coordinates) == 0 || len(p.Coordinates[0].Coordinates) == 0 {
+		return p
+	}
+	vertices := map[geometry.Point]bool{}
+	for _, q := range placed {
+		for _, r := range q.Coordinates {
+			for _, c := range r.Coordinates {
+				vertices[c] = true
+			}
+		}
+	}
+	ref := placed[0].Coordinates[0].Coordinates[0].Lng
+
+	best := p
+	bestShared, bestDist := -1, math.Inf(1)
+	for _, s := range []float64{0, -360, 360} {
+		q := *shiftPolygon(p, s)
+		shared := 0
+		for _, c := range q.Coordinates[0].Coordinates {
+			if vertices[c] {
+				shared++
+			}
+		}
+		dist := math.Abs(q.Coordinates[0].Coordinates[0].Lng - ref)
+		if shared > bestShared || (shared == bestShared && dist < bestDist) {
+			best, bestShared, bestDist = q, shared, dist
+		}
+	}
+	return best
+}
+
+// crossings returns the antimeridians, as longitudes of 180 + k * 360, crossed when moving from a to b.
+// A meridian at a isn't crossed while a meridian at b is, so that every crossing is found exactly once.
+func crossings(a float64, b float64) []float64 {
+	res := []float64{}
+	if b > a {
+		for m := 180 + 360*(math.Floor((a-180)/360)+1); m <= b; m += 360 {
+			res = append(res, m)
+		}
+	} else if b < a {
+		for m := 180 + 360*(math.Ceil((a-180)/360)-1); m >= b; m -= 360 {
+			res = append(res, m)
+		}
+	}
+	return res
+}
+
+// crossingLat returns the latitude where the great circle through a and b meets the meridian m.
+func crossingLat(a geometry.Point, b geometry.Point, m float64) float64 {
+	if m == b.Lng {
+		return b.Lat
+	}
+	λ1 := a.Lng * math.Pi / 180
+	λ2 := b.Lng * math.Pi / 180
+	λ := m * math.Pi / 180
+	d := math.Sin(λ1 - λ2)
+	if math.Abs(d) < 1e-12 || math.Abs(a.Lat) == 90 || math.Abs(b.Lat) == 90 {
+		// meridional segments and segments over a pole are interpolated linearly
+		return a.Lat + (b.Lat-a.Lat)*(m-a.Lng)/(b.Lng-a.Lng)
+	}
+	φ1 := a.Lat * math.Pi / 180
+	φ2 := b.Lat * math.Pi / 180
+	φ := math.Atan((math.Tan(φ1)*math.Sin(λ-λ2) - math.Tan(φ2)*math.Sin(λ-λ1)) / d)
+	return φ * 180 / math.Pi
+}
+
+// strip returns the index k of the 360 degrees wide strip [-180 + k * 360, 180 + k * 360) containing the longitude.
+func strip(lng float64) int {
+	return int(math.Floor((lng + 180) / 360))
+}
+
+// normalizePoints moves the points by whole turns into [-180, 180], using the middle of the first segment
+// so that points on the antimeridian stay on the side of the rest of the points.
+func normalizePoints(pts []geometry.Point) []geometry.Point {
+	if len(pts) == 0 {
+		return pts
+	}
+	mid := pts[0].Lng
+	if len(pts) > 1 {
+		mid = (pts[0].Lng + pts[1].Lng) / 2
+	}
+	return shiftPoints(pts, -360*float64(strip(mid)))
+}
+
+func shiftPoints(pts []geometry.Point, s float64) []geometry.Point {
+	res := make([]geometry.Point, len(pts))
+	for i, p := range pts {
+		res[i] = geometry.Point{Lng: p.Lng + s, Lat: p.Lat}
+	}
+	return res
+}
+
+func shiftPolygon(p geometry.Polygon, s float64) *geometry.Polygon {
+	res := geometry.Polygon{}
+	for _, r := range p.Coordinates {
+		res.Coordinates = append(res.Coordinates, geometry.LineString{Coordinates: shiftPoints(r.Coordinates, s)})
+	}
+	return &res
+}
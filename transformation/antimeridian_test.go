@@ -0,0 +1,139 @@
+package transformation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+func TestAntimeridianCutLineString(t *testing.T) {
+	ln := &geometry.LineString{Coordinates: []geometry.Point{{Lng: 170, Lat: 0}, {Lng: -170, Lat: 0}, {Lng: -160, Lat: 0}}}
+	g, err := AntimeridianCut(ln)
+	if err != nil {
+		t.Errorf("AntimeridianCut error %v", err)
+	}
+	assert.Equal(t, g.GeoJSONType, geojson.MultiLineString)
+	assert.Equal(t, g.Coordinates, [][][]float64{{{170, 0}, {180, 0}}, {{-180, 0}, {-170, 0}, {-160, 0}}})
+
+	u, err := AntimeridianUnwrap(g)
+	if err != nil {
+		t.Errorf("AntimeridianUnwrap error %v", err)
+	}
+	assert.Equal(t, u.GeoJSONType, geojson.LineString)
+	assert.Equal(t, u.Coordinates, [][]float64{{170, 0}, {180, 0}, {190, 0}, {200, 0}})
+}
+
+func TestAntimeridianCutGreatCircle(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[170, 10], [190, 20]] } }")
+	if err != nil {
+		t.Errorf("FromJSON error %v", err)
+	}
+	g, err := AntimeridianCut(f)
+	if err != nil {
+		t.Errorf("AntimeridianCut error %v", err)
+	}
+	ml, err := g.ToMultiLineString()
+	if err != nil {
+		t.Errorf("ToMultiLineString error %v", err)
+	}
+	assert.Equal(t, len(ml.Coordinates), 2)
+	c := ml.Coordinates[0].Coordinates[1]
+	assert.Equal(t, c.Lng, 180.0)
+	// the great circle bends towards the pole
+	assert.True(t, c.Lat > 15 && c.Lat < 16)
+	assert.Equal(t, ml.Coordinates[1].Coordinates[0], geometry.Point{Lng: -180, Lat: c.Lat})
+	assert.Equal(t, ml.Coordinates[1].Coordinates[1], geometry.Point{Lng: -170, Lat: 20})
+}
+
+func TestAntimeridianCutPolygon(t *testing.T) {
+	poly := &geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 170, Lat: 0}, {Lng: -170, Lat: 0}, {Lng: -170, Lat: 10}, {Lng: 170, Lat: 10}, {Lng: 170, Lat: 0},
+	}}}}
+	g, err := AntimeridianCut(poly)
+	if err != nil {
+		t.Errorf("AntimeridianCut error %v", err)
+	}
+	mp, err := g.ToMultiPolygon()
+	if err != nil {
+		t.Errorf("ToMultiPolygon error %v", err)
+	}
+	assert.Equal(t, len(mp.Coordinates), 2)
+	area := math.Abs(planar.SignedArea(mp.Coordinates[0].Coordinates[0].Coordinates))
+	assert.Equal(t, math.Abs(planar.SignedArea(mp.Coordinates[1].Coordinates[0].Coordinates)), area)
+	// the northern edge crosses the antimeridian north of 10 degrees along its great circle
+	assert.True(t, area > 100 && area < 101)
+	for _, p := range mp.Coordinates {
+		for _, c := range p.Coordinates[0].Coordinates {
+			assert.True(t, c.Lng >= 170 || c.Lng <= -170)
+		}
+	}
+
+	u, err := AntimeridianUnwrap(g)
+	if err != nil {
+		t.Errorf("AntimeridianUnwrap error %v", err)
+	}
+	assert.Equal(t, u.GeoJSONType, geojson.Polygon)
+	p, err := u.ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error %v", err)
+	}
+	assert.True(t, math.Abs(math.Abs(planar.SignedArea(p.Coordinates[0].Coordinates))-2*area) < 1e-9)
+	for _, c := range p.Coordinates[0].Coordinates {
+		assert.True(t, c.Lng >= 170 && c.Lng <= 190)
+	}
+}
+
+func TestAntimeridianCutNotCrossing(t *testing.T) {
+	poly := &geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 190, Lat: 0}, {Lng: 200, Lat: 0}, {Lng: 200, Lat: 10}, {Lng: 190, Lat: 0},
+	}}}}
+	g, err := AntimeridianCut(poly)
+	if err != nil {
+		t.Errorf("AntimeridianCut error %v", err)
+	}
+	assert.Equal(t, g.GeoJSONType, geojson.Polygon)
+	assert.Equal(t, g.Coordinates, [][][]float64{{{-170, 0}, {-160, 0}, {-160, 10}, {-170, 0}}})
+
+	g, err = AntimeridianCut(&geometry.LineString{Coordinates: []geometry.Point{{Lng: 10, Lat: 0}, {Lng: 20, Lat: 5}}})
+	if err != nil {
+		t.Errorf("AntimeridianCut error %v", err)
+	}
+	ln, err := g.ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+	}
+	assert.Equal(t, ln.Coordinates, []geometry.Point{{Lng: 10, Lat: 0}, {Lng: 20, Lat: 5}})
+}
+
+func TestAntimeridianCutPole(t *testing.T) {
+	poly := &geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 80}, {Lng: 90, Lat: 80}, {Lng: 180, Lat: 80}, {Lng: -90, Lat: 80}, {Lng: 0, Lat: 80},
+	}}}}
+	g, err := AntimeridianCut(poly)
+	if err != nil {
+		t.Errorf("AntimeridianCut error %v", err)
+	}
+	mp, err := g.ToMultiPolygon()
+	if err != nil {
+		t.Errorf("ToMultiPolygon error %v", err)
+	}
+	area := 0.0
+	for _, p := range mp.Coordinates {
+		area += math.Abs(planar.SignedArea(p.Coordinates[0].Coordinates))
+		for _, c := range p.Coordinates[0].Coordinates {
+			assert.True(t, c.Lng >= -180 && c.Lng <= 180 && c.Lat >= 80)
+		}
+	}
+	// the cap is closed along the north pole
+	assert.True(t, math.Abs(area-3600) < 1e-6)
+}
+
+func TestAntimeridianCutUnsupported(t *testing.T) {
+	_, err := AntimeridianCut(&geometry.Point{Lng: 1, Lat: 1})
+	assert.Equal(t, err.Error(), "geometry must be a LineString, MultiLineString, Polygon or MultiPolygon")
+}
@@ -0,0 +1,219 @@
+package transformation
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+// BBoxClip takes a Feature, Geometry or geometry type and a bbox and clips the geometry to the bbox.
+// Lines are clipped with the Cohen-Sutherland algorithm and a LineString that leaves and re-enters the bbox
+// becomes a MultiLineString. Polygon rings are clipped with the Sutherland-Hodgman algorithm and the rings that
+// end up outside the bbox are dropped. A bbox with West greater than East wraps around the 180th meridian.
+// The properties and the id of a Feature are kept. An error is returned if nothing of the geometry is within the bbox.
+//
+// Examples:
+//
+//	f, err := transformation.BBoxClip(ln, geojson.BBOX{West: 0, South: 0, East: 10, North: 10})
+//	ml, err := f.ToMultiLineString()
+func BBoxClip(t interface{}, bbox geojson.BBOX) (*feature.Feature, error) {
+	var properties map[string]interface{}
+	id := ""
+	if f, ok := t.(*feature.Feature); ok {
+		properties = f.Properties
+		id = f.ID
+	}
+
+	boxes := []geojson.BBOX{bbox}
+	if bbox.West > bbox.East {
+		boxes = []geojson.BBOX{
+			{West: bbox.West, South: bbox.South, East: 180, North: bbox.North},
+			{West: -180, South: bbox.South, East: bbox.East, North: bbox.North},
+		}
+	}
+
+	var geom geometry.Geometry
+	if lines, err := common.Lines(t); err == nil {
+		parts := []geometry.LineString{}
+		for _, b := range boxes {
+			for _, l := range lines {
+				for _, p := range clipLine(l.Coordinates, b) {
+					parts = append(parts, geometry.LineString{Coordinates: p})
+				}
+			}
+		}
+		if len(parts) == 0 {
+			return nil, errors.New("the geometry is outside of the bbox")
+		}
+		geom = geometry.Geometry{GeoJSONType: geojson.MultiLineString, Coordinates: common.MultiLineStringCoords(parts)}
+		if len(parts) == 1 && geometryType(t) == geojson.LineString {
+			geom = geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: common.LineStringCoords(parts[0].Coordinates)}
+		}
+	} else {
+		polys, err := common.Polygons(t)
+		if err != nil {
+			return nil, errors.New("geometry must be a LineString, MultiLineString, Polygon or MultiPolygon")
+		}
+		parts := []geometry.Polygon{}
+		for _, b := range boxes {
+			for _, p := range polys {
+				if c, ok := clipPolygon(p, b); ok {
+					parts = append(parts, c)
+				}
+			}
+		}
+		if len(parts) == 0 {
+			return nil, errors.New("the geometry is outside of the bbox")
+		}
+		geom = geometry.Geometry{GeoJSONType: geojson.MultiPolygon, Coordinates: common.MultiPolygonCoords(parts)}
+		if len(parts) == 1 && geometryType(t) == geojson.Polygon {
+			geom = geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: common.PolygonCoords(parts[0])}
+		}
+	}
+
+	return feature.New(geom, nil, properties, id)
+}
+
+// geometryType returns the geometry type of t.
+func geometryType(t interface{}) geojson.OBjectType {
+	switch gtp := t.(type) {
+	case *feature.Feature:
+		return gtp.Geometry.GeoJSONType
+	case *geometry.Geometry:
+		return gtp.GeoJSONType
+	case *geometry.LineString:
+		return geojson.LineString
+	case *geometry.Polygon:
+		return geojson.Polygon
+	}
+	return ""
+}
+
+// clipLine clips the line to the bbox using the Cohen-Sutherland algorithm and returns the parts within the bbox.
+func clipLine(points []geometry.Point, bbox geojson.BBOX) [][]geometry.Point {
+	parts := [][]geometry.Point{}
+	if len(points) == 0 {
+		return parts
+	}
+
+	part := []geometry.Point{}
+	codeA := bitCode(points[0], bbox)
+	for i := 1; i < len(points); i++ {
+		a := points[i-1]
+		b := points[i]
+		codeB := bitCode(b, bbox)
+		lastCode := codeB
+
+		for {
+			if codeA|codeB == 0 {
+				// the segment is within the bbox
+				part = append(part, a)
+				if codeB != lastCode {
+					// the segment leaves the bbox
+					part = append(part, b)
+					if i < len(points)-1 {
+						parts = append(parts, part)
+						part = []geometry.Point{}
+					}
+				} else if i == len(points)-1 {
+					part = append(part, b)
+				}
+				break
+			} else if codeA&codeB != 0 {
+				// the segment is outside of the bbox
+				break
+			} else if codeA != 0 {
+				a = intersectEdge(a, b, codeA, bbox)
+				codeA = bitCode(a, bbox)
+			} else {
+				b = intersectEdge(a, b, codeB, bbox)
+				codeB = bitCode(b, bbox)
+			}
+		}
+		codeA = lastCode
+	}
+
+	if len(part) > 0 {
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+// clipPolygon clips the rings of the polygon to the bbox using the Sutherland-Hodgman algorithm.
+// It returns false if the outer ring is outside of the bbox.
+func clipPolygon(p geometry.Polygon, bbox geojson.BBOX) (geometry.Polygon, bool) {
+	res := geometry.Polygon{}
+	for i, r := range p.Coordinates {
+		ring := clipRing(r.Coordinates, bbox)
+		if len(ring) < 4 {
+			if i == 0 {
+				return res, false
+			}
+			continue
+		}
+		res.Coordinates = append(res.Coordinates, geometry.LineString{Coordinates: ring})
+	}
+	return res, true
+}
+
+func clipRing(points []geometry.Point, bbox geojson.BBOX) []geometry.Point {
+	// clip against each side of the bbox
+	for edge := 1; edge <= 8; edge *= 2 {
+		result := []geometry.Point{}
+		if len(points) == 0 {
+			break
+		}
+		prev := points[len(points)-1]
+		prevInside := bitCode(prev, bbox)&edge == 0
+		for _, p := range points {
+			inside := bitCode(p, bbox)&edge == 0
+			if inside != prevInside {
+				result = append(result, intersectEdge(prev, p, edge, bbox))
+			}
+			if inside {
+				result = append(result, p)
+			}
+			prev = p
+			prevInside = inside
+		}
+		points = result
+	}
+
+	if len(points) > 0 && points[0] != points[len(points)-1] {
+		points = append(points, points[0])
+	}
+	return points
+}
+
+// intersectEdge returns the point where the segment meets the edge of the bbox.
+func intersectEdge(a geometry.Point, b geometry.Point, edge int, bbox geojson.BBOX) geometry.Point {
+	switch {
+	case edge&8 != 0:
+		return geometry.Point{Lng: a.Lng + (b.Lng-a.Lng)*(bbox.North-a.Lat)/(b.Lat-a.Lat), Lat: bbox.North}
+	case edge&4 != 0:
+		return geometry.Point{Lng: a.Lng + (b.Lng-a.Lng)*(bbox.South-a.Lat)/(b.Lat-a.Lat), Lat: bbox.South}
+	case edge&2 != 0:
+		return geometry.Point{Lng: bbox.East, Lat: a.Lat + (b.Lat-a.Lat)*(bbox.East-a.Lng)/(b.Lng-a.Lng)}
+	default:
+		return geometry.Point{Lng: bbox.West, Lat: a.Lat + (b.Lat-a.Lat)*(bbox.West-a.Lng)/(b.Lng-a.Lng)}
+	}
+}
+
+// bitCode returns the Cohen-Sutherland region code of the point: left 1, right 2, bottom 4 and top 8.
+func bitCode(p geometry.Point, bbox geojson.BBOX) int {
+	code := 0
+	if p.Lng < bbox.West {
+		code |= 1
+	} else if p.Lng > bbox.East {
+		code |= 2
+	}
+	if p.Lat < bbox.South {
+		code |= 4
+	} else if p.Lat > bbox.North {
+		code |= 8
+	}
+	return code
+}
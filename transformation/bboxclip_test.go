@@ -0,0 +1,82 @@
+package transformation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+func TestBBoxClipLineString(t *testing.T) {
+	bbox := geojson.BBOX{West: 0, South: 0, East: 10, North: 10}
+	tests := map[string]struct {
+		line *geometry.LineString
+		want geometry.Geometry
+	}{
+		"crossing": {
+			line: &geometry.LineString{Coordinates: []geometry.Point{{Lng: -5, Lat: 5}, {Lng: 15, Lat: 5}}},
+			want: geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: [][]float64{{0, 5}, {10, 5}}},
+		},
+		"inside": {
+			line: &geometry.LineString{Coordinates: []geometry.Point{{Lng: 1, Lat: 1}, {Lng: 2, Lat: 2}, {Lng: 3, Lat: 1}}},
+			want: geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: [][]float64{{1, 1}, {2, 2}, {3, 1}}},
+		},
+		"leaving and re-entering": {
+			line: &geometry.LineString{Coordinates: []geometry.Point{{Lng: 2, Lat: 2}, {Lng: 2, Lat: 12}, {Lng: 8, Lat: 12}, {Lng: 8, Lat: 2}}},
+			want: geometry.Geometry{GeoJSONType: geojson.MultiLineString, Coordinates: [][][]float64{{{2, 2}, {2, 10}}, {{8, 10}, {8, 2}}}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := BBoxClip(tt.line, bbox)
+			if err != nil {
+				t.Errorf("BBoxClip() error = %v", err)
+				return
+			}
+			assert.Equal(t, f.Geometry, tt.want)
+		})
+	}
+}
+
+func TestBBoxClipPolygon(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"id\": \"a\", \"properties\": { \"name\": \"square\" }, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[-5, -5], [5, -5], [5, 5], [-5, 5], [-5, -5]], [[-1, -1], [-1, 1], [1, 1], [1, -1], [-1, -1]]] } }")
+	if err != nil {
+		t.Errorf("FromJSON error %v", err)
+	}
+	clipped, err := BBoxClip(f, geojson.BBOX{West: 0, South: 0, East: 10, North: 10})
+	if err != nil {
+		t.Errorf("BBoxClip error %v", err)
+	}
+	assert.Equal(t, clipped.ID, "a")
+	assert.Equal(t, clipped.Properties["name"], "square")
+
+	poly, err := clipped.ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error %v", err)
+	}
+	assert.Equal(t, len(poly.Coordinates), 2)
+	assert.Equal(t, math.Abs(planar.SignedArea(poly.Coordinates[0].Coordinates)), 25.0)
+	assert.Equal(t, math.Abs(planar.SignedArea(poly.Coordinates[1].Coordinates)), 1.0)
+}
+
+func TestBBoxClipWrapped(t *testing.T) {
+	ln := &geometry.LineString{Coordinates: []geometry.Point{{Lng: 160, Lat: 0}, {Lng: 179, Lat: 0}}}
+	f, err := BBoxClip(ln, geojson.BBOX{West: 170, South: -10, East: -170, North: 10})
+	if err != nil {
+		t.Errorf("BBoxClip error %v", err)
+	}
+	assert.Equal(t, f.Geometry.Coordinates, [][]float64{{170, 0}, {179, 0}})
+}
+
+func TestBBoxClipEmpty(t *testing.T) {
+	ln := &geometry.LineString{Coordinates: []geometry.Point{{Lng: 20, Lat: 20}, {Lng: 30, Lat: 30}}}
+	_, err := BBoxClip(ln, geojson.BBOX{West: 0, South: 0, East: 10, North: 10})
+	assert.Equal(t, err.Error(), "the geometry is outside of the bbox")
+
+	_, err = BBoxClip(&geometry.Point{Lng: 1, Lat: 1}, geojson.BBOX{West: 0, South: 0, East: 10, North: 10})
+	assert.Equal(t, err.Error(), "geometry must be a LineString, MultiLineString, Polygon or MultiPolygon")
+}
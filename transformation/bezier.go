@@ -0,0 +1,120 @@
+package transformation
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+// BezierSplineOptions ...
+type BezierSplineOptions struct {
+	// Resolution is the duration of the spline, the spline is sampled every 10 units of it. 10000 is the default value
+	Resolution *int
+	// Sharpness is a measure of how curvy the path should be between the positions, 0 gives straight segments. 0.85 is the default value
+	Sharpness *float64
+}
+
+// BezierSpline takes a LineString and returns a curved version of it by applying a Bezier spline algorithm.
+// The bezier spline implementation is by Leszek Rybicki http://leszek.rybicki.cc/
+// t can be a Feature, Geometry or LineString and the properties of a Feature are kept.
+//
+// Examples:
+//
+//	f, err := transformation.BezierSpline(ln, BezierSplineOptions{Sharpness: common.Float64Ptr(0.5)})
+//	curved, err := f.ToLineString()
+func BezierSpline(t interface{}, options BezierSplineOptions) (*feature.Feature, error) {
+	if options.Resolution == nil {
+		options.Resolution = common.IntPtr(10000)
+	}
+	if options.Sharpness == nil {
+		options.Sharpness = common.Float64Ptr(0.85)
+	}
+	if *options.Resolution < 10 {
+		return nil, errors.New("resolution must be at least 10")
+	}
+
+	lines, err := common.Lines(t)
+	if err != nil || geometryType(t) != geojson.LineString {
+		return nil, errors.New("geometry must be a LineString")
+	}
+	points := lines[0].Coordinates
+	if len(points) < 2 {
+		return nil, errors.New("line must have at least two positions")
+	}
+
+	s := newSpline(points, float64(*options.Resolution), *options.Sharpness)
+	coords := []geometry.Point{}
+	for i := 0; i < *options.Resolution; i += 10 {
+		coords = append(coords, s.pos(float64(i)))
+	}
+	coords = append(coords, s.pos(s.duration))
+
+	var properties map[string]interface{}
+	if f, ok := t.(*feature.Feature); ok {
+		properties = f.Properties
+	}
+	geom := geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: common.LineStringCoords(coords)}
+	return feature.New(geom, nil, properties, "")
+}
+
+// spline is a sequence of cubic Bezier curves through the points, with control points
+// placed between the centers of the adjacent segments according to the sharpness.
+type spline struct {
+	points   []geometry.Point
+	controls [][2]geometry.Point
+	duration float64
+}
+
+func newSpline(points []geometry.Point, duration float64, sharpness float64) *spline {
+	centers := []geometry.Point{}
+	for i := 0; i < len(points)-1; i++ {
+		centers = append(centers, geometry.Point{
+			Lng: (points[i].Lng + points[i+1].Lng) / 2,
+			Lat: (points[i].Lat + points[i+1].Lat) / 2,
+		})
+	}
+
+	controls := [][2]geometry.Point{{points[0], points[0]}}
+	for i := 0; i < len(centers)-1; i++ {
+		p := points[i+1]
+		dx := p.Lng - (centers[i].Lng+centers[i+1].Lng)/2
+		dy := p.Lat - (centers[i].Lat+centers[i+1].Lat)/2
+		controls = append(controls, [2]geometry.Point{
+			{
+				Lng: (1-sharpness)*p.Lng + sharpness*(centers[i].Lng+dx),
+				Lat: (1-sharpness)*p.Lat + sharpness*(centers[i].Lat+dy),
+			},
+			{
+				Lng: (1-sharpness)*p.Lng + sharpness*(centers[i+1].Lng+dx),
+				Lat: (1-sharpness)*p.Lat + sharpness*(centers[i+1].Lat+dy),
+			},
+		})
+	}
+	controls = append(controls, [2]geometry.Point{points[len(points)-1], points[len(points)-1]})
+
+	return &spline{points: points, controls: controls, duration: duration}
+}
+
+// pos returns the position on the spline at the given time.
+func (s *spline) pos(time float64) geometry.Point {
+	t := math.Max(time, 0) / s.duration
+	if t >= 1 {
+		return s.points[len(s.points)-1]
+	}
+	n := int(math.Floor(float64(len(s.points)-1) * t))
+	t1 := float64(len(s.points)-1)*t - float64(n)
+	return bezier(t1, s.points[n], s.controls[n][1], s.controls[n+1][0], s.points[n+1])
+}
+
+// bezier returns the position on the cubic Bezier curve from p1 to p2 with the control points c1 and c2.
+func bezier(t float64, p1 geometry.Point, c1 geometry.Point, c2 geometry.Point, p2 geometry.Point) geometry.Point {
+	b := [4]float64{t * t * t, 3 * t * t * (1 - t), 3 * t * (1 - t) * (1 - t), (1 - t) * (1 - t) * (1 - t)}
+	return geometry.Point{
+		Lng: p2.Lng*b[0] + c2.Lng*b[1] + c1.Lng*b[2] + p1.Lng*b[3],
+		Lat: p2.Lat*b[0] + c2.Lat*b[1] + c1.Lat*b[2] + p1.Lat*b[3],
+	}
+}
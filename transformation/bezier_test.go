@@ -0,0 +1,57 @@
+package transformation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+func TestBezierSpline(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": { \"stroke\": \"#f00\" }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [1, 1], [2, 0]] } }")
+	if err != nil {
+		t.Errorf("FromJSON error %v", err)
+	}
+	res, err := BezierSpline(f, BezierSplineOptions{})
+	if err != nil {
+		t.Errorf("BezierSpline error %v", err)
+	}
+	assert.Equal(t, res.Properties["stroke"], "#f00")
+
+	curve, err := res.ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+	}
+	assert.Equal(t, len(curve.Coordinates), 1001)
+	assert.Equal(t, curve.Coordinates[0], geometry.Point{Lng: 0, Lat: 0})
+	// the curve passes through every position of the line
+	assert.Equal(t, curve.Coordinates[500], geometry.Point{Lng: 1, Lat: 1})
+	assert.Equal(t, curve.Coordinates[1000], geometry.Point{Lng: 2, Lat: 0})
+	// and bends away from the straight segments
+	assert.True(t, curve.Coordinates[250].Lat > curve.Coordinates[250].Lng)
+}
+
+func TestBezierSplineSharpness(t *testing.T) {
+	ln := &geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 1}, {Lng: 2, Lat: 0}}}
+	res, err := BezierSpline(ln, BezierSplineOptions{Resolution: common.IntPtr(100), Sharpness: common.Float64Ptr(0)})
+	if err != nil {
+		t.Errorf("BezierSpline error %v", err)
+	}
+	curve, err := res.ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+	}
+	assert.Equal(t, len(curve.Coordinates), 11)
+	// without sharpness the curve follows the segments
+	for _, p := range curve.Coordinates {
+		assert.True(t, math.Abs(p.Lat-(1-math.Abs(p.Lng-1))) < 1e-9)
+	}
+}
+
+func TestBezierSplineInvalid(t *testing.T) {
+	_, err := BezierSpline(&geometry.Point{}, BezierSplineOptions{})
+	assert.Equal(t, err.Error(), "geometry must be a LineString")
+}
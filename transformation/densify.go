@@ -0,0 +1,81 @@
+package transformation
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// DensifyOptions ...
+type DensifyOptions struct {
+	// Units of the maximum distance. constants.UnitKilometers is the default value
+	Units string
+	// Mutate allows the input GeoJSON to be changed in place, otherwise a clone is densified. false is the default value
+	Mutate bool
+}
+
+// Densify inserts positions along the great circles between the positions of every line and ring of the GeoJSON object,
+// so that no segment is longer than maxDistance. The segments are split in equal parts and the altitude of the
+// inserted positions is interpolated when both ends have one. Points and MultiPoints are returned unchanged.
+// geojson can be a FeatureCollection, Feature, GeometryCollection, Geometry or any geometry type and the returned
+// object has the same type.
+//
+// Examples:
+//
+//	res, err := transformation.Densify(ln, 50, DensifyOptions{Units: constants.UnitMiles})
+//	dense := res.(*geometry.LineString)
+func Densify(geojson interface{}, maxDistance float64, options DensifyOptions) (interface{}, error) {
+	if geojson == nil {
+		return nil, errors.New("geojson is required")
+	}
+	if options.Units == "" {
+		options.Units = constants.UnitKilometers
+	}
+	if maxDistance <= 0 {
+		return nil, errors.New("max distance must be a positive number")
+	}
+
+	fn := func(coords [][]float64) ([][]float64, error) {
+		return densifyLine(coords, maxDistance, options.Units)
+	}
+
+	return common.EachGeometry(geojson, options.Mutate, func(g *geometry.Geometry) error {
+		return common.EachLine(g, fn)
+	})
+}
+
+// densifyLine splits every segment longer than maxDistance in equal parts along its great circle.
+func densifyLine(coords [][]float64, maxDistance float64, units string) ([][]float64, error) {
+	if len(coords) == 0 {
+		return coords, nil
+	}
+
+	res := [][]float64{coords[0]}
+	for i := 1; i < len(coords); i++ {
+		start, end := coords[i-1], coords[i]
+		d, err := measurement.Distance(start[0], start[1], end[0], end[1], units)
+		if err != nil {
+			return nil, err
+		}
+		n := int(math.Ceil(d / maxDistance))
+		bearing := measurement.Bearing(start[0], start[1], end[0], end[1])
+		for k := 1; k < n; k++ {
+			f := float64(k) / float64(n)
+			p, err := measurement.Destination(geometry.Point{Lng: start[0], Lat: start[1]}, d*f, bearing, units)
+			if err != nil {
+				return nil, err
+			}
+			pos := []float64{common.NormalizeLng(p.Lng), p.Lat}
+			if len(start) > 2 && len(end) > 2 {
+				pos = append(pos, start[2]+(end[2]-start[2])*f)
+			}
+			res = append(res, pos)
+		}
+		res = append(res, end)
+	}
+	return res, nil
+}
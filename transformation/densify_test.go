@@ -0,0 +1,92 @@
+package transformation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+func TestDensifyLineString(t *testing.T) {
+	ln := &geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 10, Lat: 0}, {Lng: 10, Lat: 0.1}}}
+	d, err := measurement.Distance(0, 0, 10, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+	res, err := Densify(ln, d/4+1, DensifyOptions{})
+	if err != nil {
+		t.Errorf("Densify error %v", err)
+	}
+	equalPoints(t, res.(*geometry.LineString).Coordinates, []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 2.5, Lat: 0}, {Lng: 5, Lat: 0}, {Lng: 7.5, Lat: 0}, {Lng: 10, Lat: 0}, {Lng: 10, Lat: 0.1},
+	})
+	// the input is cloned
+	assert.Equal(t, len(ln.Coordinates), 3)
+}
+
+func TestDensifyGreatCircle(t *testing.T) {
+	tests := map[string]struct {
+		geojson string
+	}{
+		"polygon": {
+			geojson: "{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[-10, 40], [10, 40], [10, 60], [-10, 60], [-10, 40]]] } }",
+		},
+		"antimeridian": {
+			geojson: "{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[170, 50], [-170, 50]] } }",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := feature.FromJSON(tt.geojson)
+			if err != nil {
+				t.Errorf("FromJSON error %v", err)
+				return
+			}
+			res, err := Densify(f, 100, DensifyOptions{})
+			if err != nil {
+				t.Errorf("Densify error %v", err)
+				return
+			}
+			var lines []geometry.LineString
+			if f.Geometry.GeoJSONType == geojson.Polygon {
+				p, _ := res.(*feature.Feature).ToPolygon()
+				lines = p.Coordinates
+			} else {
+				l, _ := res.(*feature.Feature).ToLineString()
+				lines = []geometry.LineString{*l}
+			}
+			for _, l := range lines {
+				pts := l.Coordinates
+				assert.True(t, len(pts) > 10)
+				for i := 1; i < len(pts); i++ {
+					assert.True(t, math.Abs(pts[i].Lng) <= 180)
+					d, _ := measurement.PointDistance(pts[i-1], pts[i], constants.UnitKilometers)
+					assert.True(t, d <= 100+1e-9)
+				}
+			}
+		})
+	}
+}
+
+func TestDensifyAltitude(t *testing.T) {
+	g := &geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: [][]float64{{0, 0, 100}, {0, 1, 200}}}
+	res, err := Densify(g, 30, DensifyOptions{Units: constants.UnitMiles})
+	if err != nil {
+		t.Errorf("Densify error %v", err)
+	}
+	coords := res.(*geometry.Geometry).Coordinates.([][]float64)
+	assert.Equal(t, len(coords), 4)
+	assert.True(t, math.Abs(coords[1][1]-1.0/3) < 1e-9)
+	assert.True(t, math.Abs(coords[1][2]-100-100.0/3) < 1e-9)
+	assert.Equal(t, coords[3], []float64{0, 1, 200})
+}
+
+func TestDensifyInvalid(t *testing.T) {
+	_, err := Densify(&geometry.LineString{}, 0, DensifyOptions{})
+	assert.Equal(t, err.Error(), "max distance must be a positive number")
+}
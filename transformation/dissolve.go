@@ -0,0 +1,76 @@
+package transformation
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/internal/planar"
+)
+
+// Dissolve merges the overlapping or adjacent polygons of the FeatureCollection that share the same value of the property,
+// removing the boundaries between them. Every distinct value, in the order it first appears, returns a Polygon feature,
+// or a MultiPolygon feature if the merged area is not connected, with the value as its only property.
+// Features without the property are dissolved together and an empty propertyName dissolves all the features.
+// The features must be Polygons or MultiPolygons, whose holes are kept unless they are covered by another polygon.
+//
+// Examples:
+//
+//	fc, err := transformation.Dissolve(parcels, "zone")
+func Dissolve(fc *feature.Collection, propertyName string) (*feature.Collection, error) {
+	if fc == nil {
+		return nil, errors.New("feature collection is required")
+	}
+
+	values := []interface{}{}
+	groups := [][]geometry.Polygon{}
+	for i := range fc.Features {
+		polys, err := common.Polygons(&fc.Features[i])
+		if err != nil {
+			return nil, err
+		}
+		var value interface{}
+		if propertyName != "" {
+			value = fc.Features[i].Properties[propertyName]
+		}
+
+		g := -1
+		for j, v := range values {
+			if reflect.DeepEqual(v, value) {
+				g = j
+				break
+			}
+		}
+		if g < 0 {
+			values = append(values, value)
+			groups = append(groups, []geometry.Polygon{})
+			g = len(groups) - 1
+		}
+		groups[g] = append(groups[g], polys...)
+	}
+
+	features := []feature.Feature{}
+	for i, polys := range groups {
+		merged := planar.Union(polys)
+		if len(merged) == 0 {
+			continue
+		}
+		var properties map[string]interface{}
+		if propertyName != "" {
+			properties = map[string]interface{}{propertyName: values[i]}
+		}
+		geom := geometry.Geometry{GeoJSONType: geojson.MultiPolygon, Coordinates: common.MultiPolygonCoords(merged)}
+		if len(merged) == 1 {
+			geom = geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: common.PolygonCoords(merged[0])}
+		}
+		f, err := feature.New(geom, nil, properties, "")
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
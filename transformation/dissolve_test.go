@@ -0,0 +1,105 @@
+package transformation
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+func square(west float64, south float64, east float64, north float64) string {
+	return fmt.Sprintf("[[%v, %v], [%v, %v], [%v, %v], [%v, %v], [%v, %v]]", west, south, east, south, east, north, west, north, west, south)
+}
+
+func squareArea(t *testing.T, west float64, south float64, east float64, north float64) float64 {
+	a, err := measurement.Area(&geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: west, Lat: south}, {Lng: east, Lat: south}, {Lng: east, Lat: north}, {Lng: west, Lat: north}, {Lng: west, Lat: south},
+	}}}})
+	if err != nil {
+		t.Errorf("Area error %v", err)
+	}
+	return a
+}
+
+func TestDissolve(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"zone\": \"a\" }, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [" + square(0, 0, 1, 1) + "] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"zone\": \"b\" }, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [" + square(10, 10, 14, 14) + ", " + square(11, 11, 12, 12) + "] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"zone\": \"a\" }, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [" + square(1, 0, 2, 1) + "] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"zone\": \"a\" }, \"geometry\": { \"type\": \"MultiPolygon\", \"coordinates\": [[" + square(1.5, 0.5, 2.5, 1.5) + "]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"zone\": \"b\" }, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [" + square(20, 10, 21, 11) + "] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	res, err := Dissolve(fc, "zone")
+	if err != nil {
+		t.Errorf("Dissolve error %v", err)
+	}
+	assert.Equal(t, len(res.Features), 2)
+
+	a := res.Features[0]
+	assert.Equal(t, a.Properties, map[string]interface{}{"zone": "a"})
+	assert.Equal(t, a.Geometry.GeoJSONType, geojson.Polygon)
+	area, err := measurement.Area(&a)
+	if err != nil {
+		t.Errorf("Area error %v", err)
+	}
+	want := squareArea(t, 0, 0, 1, 1) + squareArea(t, 1, 0, 2, 1) + squareArea(t, 1.5, 0.5, 2.5, 1.5) - squareArea(t, 1.5, 0.5, 2, 1)
+	assert.True(t, math.Abs(area-want) < 1e-6*want)
+
+	b := res.Features[1]
+	assert.Equal(t, b.Properties, map[string]interface{}{"zone": "b"})
+	mp, err := b.ToMultiPolygon()
+	if err != nil {
+		t.Errorf("ToMultiPolygon error %v", err)
+	}
+	assert.Equal(t, len(mp.Coordinates), 2)
+	assert.Equal(t, len(mp.Coordinates[0].Coordinates), 2)
+	area, err = measurement.Area(&b)
+	if err != nil {
+		t.Errorf("Area error %v", err)
+	}
+	want = squareArea(t, 10, 10, 14, 14) - squareArea(t, 11, 11, 12, 12) + squareArea(t, 20, 10, 21, 11)
+	assert.True(t, math.Abs(area-want) < 1e-6*want)
+}
+
+func TestDissolveAll(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"zone\": \"a\" }, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [" + square(0, 0, 4, 4) + ", " + square(1, 1, 2, 2) + "] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"zone\": \"b\" }, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [" + square(0.5, 0.5, 1.5, 1.5) + "] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	res, err := Dissolve(fc, "")
+	if err != nil {
+		t.Errorf("Dissolve error %v", err)
+	}
+	assert.Equal(t, len(res.Features), 1)
+	assert.Equal(t, len(res.Features[0].Properties), 0)
+	p, err := res.Features[0].ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error %v", err)
+	}
+	// the hole is partly covered by the second polygon
+	assert.Equal(t, len(p.Coordinates), 2)
+	area, err := measurement.Area(p)
+	if err != nil {
+		t.Errorf("Area error %v", err)
+	}
+	want := squareArea(t, 0, 0, 4, 4) - squareArea(t, 1, 1, 2, 2) + squareArea(t, 1, 1, 1.5, 1.5)
+	assert.True(t, math.Abs(area-want) < 1e-6*want)
+}
+
+func TestDissolveInvalid(t *testing.T) {
+	fc, _ := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0, 0] } }] }")
+	_, err := Dissolve(fc, "zone")
+	assert.Equal(t, err.Error(), "geometry must be a Polygon or a MultiPolygon")
+}
@@ -0,0 +1,113 @@
+package transformation
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/conversions"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+// LineOffset takes a LineString or a MultiLineString and returns a line at the given offset distance from it.
+// A positive distance offsets the line to the right and a negative distance to the left.
+// Consecutive offset segments are joined at the intersection of their lines, so the vertex count is kept.
+// t can be a Feature, Geometry, LineString or MultiLineString and the result keeps the geometry type and
+// the properties of a Feature.
+//
+// Examples:
+//
+//	f, err := transformation.LineOffset(ln, 10, constants.UnitMeters)
+//	offset, err := f.ToLineString()
+func LineOffset(t interface{}, distance float64, units string) (*feature.Feature, error) {
+	lines, err := common.Lines(t)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := conversions.LengthToDegrees(distance, units)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []geometry.LineString{}
+	for _, l := range lines {
+		pts, err := offsetLine(l.Coordinates, offset)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, geometry.LineString{Coordinates: pts})
+	}
+
+	var properties map[string]interface{}
+	if f, ok := t.(*feature.Feature); ok {
+		properties = f.Properties
+	}
+	geom := geometry.Geometry{GeoJSONType: geojson.MultiLineString, Coordinates: common.MultiLineStringCoords(res)}
+	if geometryType(t) == geojson.LineString {
+		geom = geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: common.LineStringCoords(res[0].Coordinates)}
+	}
+	return feature.New(geom, nil, properties, "")
+}
+
+// offsetLine offsets every segment of the line and joins the segments with mitered corners.
+// Repeated positions are offset like the position they repeat.
+func offsetLine(pts []geometry.Point, offset float64) ([]geometry.Point, error) {
+	unique := []geometry.Point{}
+	index := make([]int, len(pts))
+	for i, p := range pts {
+		if len(unique) == 0 || unique[len(unique)-1] != p {
+			unique = append(unique, p)
+		}
+		index[i] = len(unique) - 1
+	}
+	if len(unique) < 2 {
+		return nil, errors.New("line must have at least two distinct positions")
+	}
+
+	segments := make([][2]geometry.Point, len(unique)-1)
+	for i := range segments {
+		segments[i] = offsetSegment(unique[i], unique[i+1], offset)
+	}
+
+	coords := make([]geometry.Point, len(unique))
+	coords[0] = segments[0][0]
+	for i := 1; i < len(segments); i++ {
+		coords[i] = segments[i][0]
+		if p, ok := lineIntersection(segments[i-1], segments[i]); ok {
+			coords[i] = p
+		}
+	}
+	coords[len(unique)-1] = segments[len(segments)-1][1]
+
+	res := make([]geometry.Point, len(pts))
+	for i := range pts {
+		res[i] = coords[index[i]]
+	}
+	return res, nil
+}
+
+// offsetSegment moves the segment perpendicular to its direction, to the right for a positive offset.
+func offsetSegment(p1 geometry.Point, p2 geometry.Point, offset float64) [2]geometry.Point {
+	l := math.Hypot(p1.Lng-p2.Lng, p1.Lat-p2.Lat)
+	dx := offset * (p2.Lat - p1.Lat) / l
+	dy := offset * (p1.Lng - p2.Lng) / l
+	return [2]geometry.Point{
+		{Lng: p1.Lng + dx, Lat: p1.Lat + dy},
+		{Lng: p2.Lng + dx, Lat: p2.Lat + dy},
+	}
+}
+
+// lineIntersection returns the point where the infinite lines through the two segments meet.
+// It returns false for parallel lines.
+func lineIntersection(s1 [2]geometry.Point, s2 [2]geometry.Point) (geometry.Point, bool) {
+	d1x, d1y := s1[1].Lng-s1[0].Lng, s1[1].Lat-s1[0].Lat
+	d2x, d2y := s2[1].Lng-s2[0].Lng, s2[1].Lat-s2[0].Lat
+	denom := d1x*d2y - d1y*d2x
+	if denom == 0 {
+		return geometry.Point{}, false
+	}
+	u := ((s2[0].Lng-s1[0].Lng)*d2y - (s2[0].Lat-s1[0].Lat)*d2x) / denom
+	return geometry.Point{Lng: s1[0].Lng + u*d1x, Lat: s1[0].Lat + u*d1y}, true
+}
@@ -0,0 +1,76 @@
+package transformation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/conversions"
+)
+
+func TestLineOffset(t *testing.T) {
+	ln := &geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0, Lat: 10}, {Lng: 0, Lat: 10}, {Lng: 10, Lat: 10}}}
+	d, _ := conversions.LengthToDegrees(100, constants.UnitKilometers)
+	tests := map[string]struct {
+		distance float64
+		want     []geometry.Point
+	}{
+		"right": {
+			distance: 100,
+			want:     []geometry.Point{{Lng: d, Lat: 0}, {Lng: d, Lat: 10 - d}, {Lng: d, Lat: 10 - d}, {Lng: 10, Lat: 10 - d}},
+		},
+		"left": {
+			distance: -100,
+			want:     []geometry.Point{{Lng: -d, Lat: 0}, {Lng: -d, Lat: 10 + d}, {Lng: -d, Lat: 10 + d}, {Lng: 10, Lat: 10 + d}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := LineOffset(ln, tt.distance, constants.UnitKilometers)
+			if err != nil {
+				t.Errorf("LineOffset() error = %v", err)
+				return
+			}
+			assert.Equal(t, f.Geometry.GeoJSONType, geojson.LineString)
+			l, err := f.ToLineString()
+			if err != nil {
+				t.Errorf("ToLineString() error = %v", err)
+				return
+			}
+			equalPoints(t, l.Coordinates, tt.want)
+		})
+	}
+}
+
+func TestLineOffsetMultiLineString(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": { \"lane\": 1 }, \"geometry\": { \"type\": \"MultiLineString\", \"coordinates\": [[[0, 0], [10, 0]], [[0, 5], [5, 10], [10, 5]]] } }")
+	if err != nil {
+		t.Errorf("FromJSON error %v", err)
+	}
+	res, err := LineOffset(f, 10, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineOffset error %v", err)
+	}
+	assert.Equal(t, res.Properties["lane"], 1.0)
+	ml, err := res.ToMultiLineString()
+	if err != nil {
+		t.Errorf("ToMultiLineString error %v", err)
+	}
+	d, _ := conversions.LengthToDegrees(10, constants.UnitKilometers)
+	assert.True(t, math.Abs(ml.Coordinates[0].Coordinates[0].Lat+d) < 1e-9)
+	assert.True(t, math.Abs(ml.Coordinates[0].Coordinates[1].Lat+d) < 1e-9)
+	// the corner of the second line is mitered
+	assert.Equal(t, len(ml.Coordinates[1].Coordinates), 3)
+	assert.True(t, math.Abs(ml.Coordinates[1].Coordinates[1].Lng-5) < 1e-9)
+	assert.True(t, math.Abs(ml.Coordinates[1].Coordinates[1].Lat-(10-d*math.Sqrt2)) < 1e-9)
+}
+
+func TestLineOffsetInvalid(t *testing.T) {
+	ln := &geometry.LineString{Coordinates: []geometry.Point{{Lng: 1, Lat: 1}, {Lng: 1, Lat: 1}}}
+	_, err := LineOffset(ln, 1, constants.UnitKilometers)
+	assert.Equal(t, err.Error(), "line must have at least two distinct positions")
+}
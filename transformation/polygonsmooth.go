@@ -0,0 +1,100 @@
+package transformation
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+// PolygonSmoothOptions ...
+type PolygonSmoothOptions struct {
+	// Iterations is the number of times the smoothing is applied. 1 is the default value
+	Iterations *int
+}
+
+// PolygonSmooth smooths the rings of Polygons and MultiPolygons with Chaikin's corner cutting algorithm.
+// Every iteration replaces each edge of a ring with the points at a quarter and three quarters of it,
+// doubling the number of positions. Holes are smoothed like outer rings and the rings are kept closed.
+// t can be a FeatureCollection, Feature, Geometry, Polygon or MultiPolygon and every polygon feature
+// is returned in a FeatureCollection with its properties.
+//
+// Examples:
+//
+//	fc, err := transformation.PolygonSmooth(poly, PolygonSmoothOptions{Iterations: common.IntPtr(3)})
+func PolygonSmooth(t interface{}, options PolygonSmoothOptions) (*feature.Collection, error) {
+	if options.Iterations == nil {
+		options.Iterations = common.IntPtr(1)
+	}
+	if *options.Iterations < 0 {
+		return nil, errors.New("iterations must be a positive number")
+	}
+
+	features := []feature.Feature{}
+	switch gtp := t.(type) {
+	case *feature.Collection:
+		for i := range gtp.Features {
+			f, err := smoothFeature(&gtp.Features[i], *options.Iterations)
+			if err != nil {
+				return nil, err
+			}
+			features = append(features, *f)
+		}
+	default:
+		f, err := smoothFeature(t, *options.Iterations)
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+func smoothFeature(t interface{}, iterations int) (*feature.Feature, error) {
+	polys, err := common.Polygons(t)
+	if err != nil {
+		return nil, err
+	}
+
+	smoothed := []geometry.Polygon{}
+	for _, p := range polys {
+		rings := []geometry.LineString{}
+		for _, r := range p.Coordinates {
+			pts := r.Coordinates
+			for k := 0; k < iterations; k++ {
+				pts = chaikin(pts)
+			}
+			rings = append(rings, geometry.LineString{Coordinates: pts})
+		}
+		smoothed = append(smoothed, geometry.Polygon{Coordinates: rings})
+	}
+
+	var properties map[string]interface{}
+	if f, ok := t.(*feature.Feature); ok {
+		properties = f.Properties
+	}
+	geom := geometry.Geometry{GeoJSONType: geojson.MultiPolygon, Coordinates: common.MultiPolygonCoords(smoothed)}
+	if geometryType(t) == geojson.Polygon {
+		geom = geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: common.PolygonCoords(smoothed[0])}
+	}
+	return feature.New(geom, nil, properties, "")
+}
+
+// chaikin cuts the corners of the closed ring once.
+func chaikin(ring []geometry.Point) []geometry.Point {
+	res := []geometry.Point{}
+	for i := 0; i < len(ring)-1; i++ {
+		p0 := ring[i]
+		p1 := ring[i+1]
+		res = append(res,
+			geometry.Point{Lng: 0.75*p0.Lng + 0.25*p1.Lng, Lat: 0.75*p0.Lat + 0.25*p1.Lat},
+			geometry.Point{Lng: 0.25*p0.Lng + 0.75*p1.Lng, Lat: 0.25*p0.Lat + 0.75*p1.Lat},
+		)
+	}
+	if len(res) > 0 {
+		res = append(res, res[0])
+	}
+	return res
+}
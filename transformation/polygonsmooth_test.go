@@ -0,0 +1,52 @@
+package transformation
+
+import (
+	"testing"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/internal/common"
+)
+
+func TestPolygonSmooth(t *testing.T) {
+	poly := &geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 4, Lat: 0}, {Lng: 4, Lat: 4}, {Lng: 0, Lat: 4}, {Lng: 0, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 1, Lat: 1}, {Lng: 1, Lat: 3}, {Lng: 3, Lat: 3}, {Lng: 3, Lat: 1}, {Lng: 1, Lat: 1}}},
+	}}
+	fc, err := PolygonSmooth(poly, PolygonSmoothOptions{})
+	if err != nil {
+		t.Errorf("PolygonSmooth error %v", err)
+	}
+	assert.Equal(t, len(fc.Features), 1)
+	assert.Equal(t, fc.Features[0].Geometry.GeoJSONType, geojson.Polygon)
+	assert.Equal(t, fc.Features[0].Geometry.Coordinates, [][][]float64{
+		{{1, 0}, {3, 0}, {4, 1}, {4, 3}, {3, 4}, {1, 4}, {0, 3}, {0, 1}, {1, 0}},
+		{{1, 1.5}, {1, 2.5}, {1.5, 3}, {2.5, 3}, {3, 2.5}, {3, 1.5}, {2.5, 1}, {1.5, 1}, {1, 1.5}},
+	})
+}
+
+func TestPolygonSmoothIterations(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"a\" }, \"geometry\": { \"type\": \"MultiPolygon\", \"coordinates\": [[[[0, 0], [4, 0], [4, 4], [0, 0]]], [[[10, 10], [14, 10], [14, 14], [10, 10]]]] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	res, err := PolygonSmooth(fc, PolygonSmoothOptions{Iterations: common.IntPtr(3)})
+	if err != nil {
+		t.Errorf("PolygonSmooth error %v", err)
+	}
+	assert.Equal(t, res.Features[0].Properties["name"], "a")
+	mp, err := res.Features[0].ToMultiPolygon()
+	if err != nil {
+		t.Errorf("ToMultiPolygon error %v", err)
+	}
+	assert.Equal(t, len(mp.Coordinates), 2)
+	for _, p := range mp.Coordinates {
+		ring := p.Coordinates[0].Coordinates
+		assert.Equal(t, len(ring), 25)
+		assert.Equal(t, ring[0], ring[len(ring)-1])
+	}
+}
@@ -0,0 +1,54 @@
+package transformation
+
+import (
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// RotateOptions ...
+type RotateOptions struct {
+	// Pivot is the point around which the rotation is performed. The centroid of the GeoJSON is the default value
+	Pivot *geometry.Point
+	// Mutate allows the input GeoJSON to be changed in place, otherwise a clone is rotated. false is the default value
+	Mutate bool
+}
+
+// TransformRotate rotates any GeoJSON object by the given angle around its centroid or the given pivot.
+// The angle is in decimal degrees, positive clockwise. Every position keeps its rhumb distance from the pivot
+// so the shape is kept. geojson can be a FeatureCollection, Feature, GeometryCollection, Geometry or any geometry type
+// and the returned object has the same type.
+//
+// Examples:
+//
+//	res, err := transformation.TransformRotate(poly, 10, RotateOptions{Pivot: &geometry.Point{Lng: 0, Lat: 25}})
+//	rotated := res.(*geometry.Polygon)
+func TransformRotate(geojson interface{}, angle float64, options RotateOptions) (interface{}, error) {
+	if angle == 0 {
+		return transform(geojson, options.Mutate, func(pos []float64) ([]float64, error) {
+			return pos, nil
+		})
+	}
+
+	pivot := options.Pivot
+	if pivot == nil && geojson != nil {
+		c, err := centroid(geojson)
+		if err != nil {
+			return nil, err
+		}
+		pivot = c
+	}
+
+	return transform(geojson, options.Mutate, func(pos []float64) ([]float64, error) {
+		p := geometry.Point{Lng: pos[0], Lat: pos[1]}
+		bearing, err := measurement.RhumbBearing(*pivot, p, false)
+		if err != nil {
+			return nil, err
+		}
+		distance, err := measurement.RhumbDistance(*pivot, p, constants.UnitMeters)
+		if err != nil {
+			return nil, err
+		}
+		return rhumbPosition(*pivot, *distance, *bearing+angle, pos)
+	})
+}
@@ -0,0 +1,76 @@
+package transformation
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+// ScaleOptions ...
+type ScaleOptions struct {
+	// Origin is the point from which the scaling is performed. The centroid of each Feature is the default value
+	Origin *geometry.Point
+	// Mutate allows the input GeoJSON to be changed in place, otherwise a clone is scaled. false is the default value
+	Mutate bool
+}
+
+// TransformScale scales any GeoJSON object by the given factor from its centroid or the given origin.
+// Factors between 0 and 1 shrink the object and factors greater than 1 expand it. The rhumb distance of every position
+// from the origin is multiplied by the factor while its rhumb bearing is kept.
+// The Features of a FeatureCollection are scaled from their own centroid unless an origin is given.
+// geojson can be a FeatureCollection, Feature, GeometryCollection, Geometry or any geometry type
+// and the returned object has the same type.
+//
+// Examples:
+//
+//	res, err := transformation.TransformScale(poly, 2, ScaleOptions{})
+//	scaled := res.(*geometry.Polygon)
+func TransformScale(geojson interface{}, factor float64, options ScaleOptions) (interface{}, error) {
+	if geojson == nil {
+		return nil, errors.New("geojson is required")
+	}
+	if factor <= 0 {
+		return nil, errors.New("factor must be a positive number")
+	}
+
+	if fc, ok := geojson.(*feature.Collection); ok && options.Origin == nil {
+		if !options.Mutate {
+			fc = common.CloneCollection(fc)
+		}
+		for i := range fc.Features {
+			if _, err := TransformScale(&fc.Features[i], factor, ScaleOptions{Mutate: true}); err != nil {
+				return nil, err
+			}
+		}
+		return fc, nil
+	}
+
+	origin := options.Origin
+	if origin == nil {
+		c, err := centroid(geojson)
+		if err != nil {
+			return nil, err
+		}
+		origin = c
+	}
+
+	return transform(geojson, options.Mutate, func(pos []float64) ([]float64, error) {
+		if factor == 1 {
+			return pos, nil
+		}
+		p := geometry.Point{Lng: pos[0], Lat: pos[1]}
+		bearing, err := measurement.RhumbBearing(*origin, p, false)
+		if err != nil {
+			return nil, err
+		}
+		distance, err := measurement.RhumbDistance(*origin, p, constants.UnitMeters)
+		if err != nil {
+			return nil, err
+		}
+		return rhumbPosition(*origin, *distance*factor, *bearing, pos)
+	})
+}
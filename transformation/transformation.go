@@ -0,0 +1,78 @@
+package transformation
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/internal/common"
+	"github.com/tomchavakis/turf-go/measurement"
+	meta "github.com/tomchavakis/turf-go/meta/coordAll"
+)
+
+// transform applies the callbackFn to every position of the GeoJSON object. The object is cloned first unless mutate is set.
+// Features and Geometries keep the altitude of their positions, the geometry types hold longitude and latitude only.
+func transform(geojson interface{}, mutate bool, callbackFn func([]float64) ([]float64, error)) (interface{}, error) {
+	if geojson == nil {
+		return nil, errors.New("geojson is required")
+	}
+
+	var err error
+	fn := func(pos []float64) []float64 {
+		if err != nil {
+			return pos
+		}
+		res, e := callbackFn(pos)
+		if e != nil {
+			err = e
+			return pos
+		}
+		return res
+	}
+
+	res, e := common.EachGeometry(geojson, mutate, func(g *geometry.Geometry) error {
+		return common.EachPosition(g, fn)
+	})
+	if e != nil {
+		return nil, e
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// centroid returns the mean position of the GeoJSON object, without the closing positions of the rings.
+func centroid(geojson interface{}) (*geometry.Point, error) {
+	if g, ok := geojson.(*geometry.Geometry); ok {
+		geojson = &geometry.Collection{Geometries: []geometry.Geometry{*g}}
+	}
+	excludeWrapCoord := true
+	coords, err := meta.CoordAll(geojson, &excludeWrapCoord)
+	if err != nil {
+		return nil, err
+	}
+	if len(coords) == 0 {
+		return nil, errors.New("no coordinates found")
+	}
+
+	c := geometry.Point{}
+	for _, p := range coords {
+		c.Lng += p.Lng
+		c.Lat += p.Lat
+	}
+	c.Lng /= float64(len(coords))
+	c.Lat /= float64(len(coords))
+	return &c, nil
+}
+
+// rhumbPosition returns the position of pos after travelling the given distance in meters along a rhumb line from origin
+// with the given bearing. The altitude of pos is kept.
+func rhumbPosition(origin geometry.Point, distance float64, bearing float64, pos []float64) ([]float64, error) {
+	dest, err := measurement.RhumbDestination(origin, distance, bearing, constants.UnitMeters, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := append([]float64{}, dest.Geometry.Coordinates.([]float64)...)
+	return append(res, pos[2:]...), nil
+}
@@ -0,0 +1,158 @@
+package transformation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/assert"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/measurement"
+)
+
+func polygon() *geometry.Polygon {
+	return &geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 29}, {Lng: 3.5, Lat: 29}, {Lng: 2.5, Lat: 32}, {Lng: 0, Lat: 29},
+	}}}}
+}
+
+func equalPoints(t *testing.T, actual []geometry.Point, expected []geometry.Point) {
+	assert.Equal(t, len(actual), len(expected))
+	for i := range expected {
+		if math.Abs(actual[i].Lng-expected[i].Lng) > 1e-6 || math.Abs(actual[i].Lat-expected[i].Lat) > 1e-6 {
+			t.Errorf("position %d: expected %v, got %v", i, expected[i], actual[i])
+		}
+	}
+}
+
+func TestTransformRotate(t *testing.T) {
+	pivot := geometry.Point{Lng: 0, Lat: 25}
+	poly := polygon()
+
+	res, err := TransformRotate(poly, 10, RotateOptions{Pivot: &pivot})
+	if err != nil {
+		t.Errorf("TransformRotate error %v", err)
+	}
+	rotated := res.(*geometry.Polygon)
+	// the input isn't changed
+	equalPoints(t, poly.Coordinates[0].Coordinates, polygon().Coordinates[0].Coordinates)
+
+	for i, p := range rotated.Coordinates[0].Coordinates {
+		o := poly.Coordinates[0].Coordinates[i]
+		d1, _ := measurement.RhumbDistance(pivot, o, constants.UnitMeters)
+		d2, _ := measurement.RhumbDistance(pivot, p, constants.UnitMeters)
+		assert.True(t, math.Abs(*d1-*d2) < 1e-3)
+		b1, _ := measurement.RhumbBearing(pivot, o, false)
+		b2, _ := measurement.RhumbBearing(pivot, p, false)
+		assert.True(t, math.Abs(*b2-*b1-10) < 1e-6)
+	}
+
+	res, err = TransformRotate(rotated, -10, RotateOptions{Pivot: &pivot, Mutate: true})
+	if err != nil {
+		t.Errorf("TransformRotate error %v", err)
+	}
+	assert.True(t, res.(*geometry.Polygon) == rotated)
+	equalPoints(t, rotated.Coordinates[0].Coordinates, poly.Coordinates[0].Coordinates)
+}
+
+func TestTransformRotateCentroid(t *testing.T) {
+	f, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[-1, 0, 10], [1, 0, 20]] } }")
+	if err != nil {
+		t.Errorf("FromJSON error %v", err)
+	}
+	res, err := TransformRotate(f, 90, RotateOptions{})
+	if err != nil {
+		t.Errorf("TransformRotate error %v", err)
+	}
+	coords := res.(*feature.Feature).Geometry.Coordinates.([][]float64)
+	assert.Equal(t, len(coords[0]), 3)
+	assert.True(t, math.Abs(coords[0][0]) < 1e-9 && math.Abs(coords[0][1]-1) < 1e-6)
+	assert.True(t, math.Abs(coords[1][0]) < 1e-9 && math.Abs(coords[1][1]+1) < 1e-6)
+	assert.Equal(t, coords[0][2], 10.0)
+	assert.Equal(t, coords[1][2], 20.0)
+}
+
+func TestTransformScale(t *testing.T) {
+	poly := polygon()
+	origin := geometry.Point{Lng: 0, Lat: 29}
+
+	res, err := TransformScale(poly, 2, ScaleOptions{Origin: &origin})
+	if err != nil {
+		t.Errorf("TransformScale error %v", err)
+	}
+	scaled := res.(*geometry.Polygon)
+	equalPoints(t, scaled.Coordinates[0].Coordinates[:1], []geometry.Point{origin})
+	for i, p := range scaled.Coordinates[0].Coordinates[1:3] {
+		o := poly.Coordinates[0].Coordinates[i+1]
+		d1, _ := measurement.RhumbDistance(origin, o, constants.UnitMeters)
+		d2, _ := measurement.RhumbDistance(origin, p, constants.UnitMeters)
+		assert.True(t, math.Abs(*d2-2**d1) < 1e-3)
+	}
+
+	res, err = TransformScale(scaled, 0.5, ScaleOptions{Origin: &origin})
+	if err != nil {
+		t.Errorf("TransformScale error %v", err)
+	}
+	equalPoints(t, res.(*geometry.Polygon).Coordinates[0].Coordinates, poly.Coordinates[0].Coordinates)
+
+	_, err = TransformScale(poly, 0, ScaleOptions{})
+	assert.Equal(t, err.Error(), "factor must be a positive number")
+}
+
+func TestTransformScaleFeatureCollection(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Point\", \"coordinates\": [10, 10] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"MultiPoint\", \"coordinates\": [[0, 1], [0, -1]] } }" +
+		"] }")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+	}
+	res, err := TransformScale(fc, 3, ScaleOptions{})
+	if err != nil {
+		t.Errorf("TransformScale error %v", err)
+	}
+	scaled := res.(*feature.Collection)
+	// each feature is scaled from its own centroid
+	assert.Equal(t, scaled.Features[0].Geometry.Coordinates, []float64{10, 10})
+	coords := scaled.Features[1].Geometry.Coordinates.([][]float64)
+	assert.True(t, math.Abs(coords[0][1]-3) < 1e-6 && math.Abs(coords[1][1]+3) < 1e-6)
+}
+
+func TestTransformTranslate(t *testing.T) {
+	poly := polygon()
+	res, err := TransformTranslate(poly, 300, 70, TranslateOptions{})
+	if err != nil {
+		t.Errorf("TransformTranslate error %v", err)
+	}
+	moved := res.(*geometry.Polygon)
+	for i, p := range moved.Coordinates[0].Coordinates {
+		o := poly.Coordinates[0].Coordinates[i]
+		d, _ := measurement.RhumbDistance(o, p, constants.UnitKilometers)
+		b, _ := measurement.RhumbBearing(o, p, false)
+		assert.True(t, math.Abs(*d-300) < 1e-6)
+		assert.True(t, math.Abs(*b-70) < 1e-6)
+	}
+
+	res, err = TransformTranslate(moved, -300, 70, TranslateOptions{})
+	if err != nil {
+		t.Errorf("TransformTranslate error %v", err)
+	}
+	equalPoints(t, res.(*geometry.Polygon).Coordinates[0].Coordinates, poly.Coordinates[0].Coordinates)
+}
+
+func TestTransformTranslateZ(t *testing.T) {
+	g, err := geometry.FromJSON("{ \"type\": \"Point\", \"coordinates\": [0, 0, 100] }")
+	if err != nil {
+		t.Errorf("FromJSON error %v", err)
+	}
+	res, err := TransformTranslate(g, 0, 0, TranslateOptions{ZTranslation: 50, Mutate: true})
+	if err != nil {
+		t.Errorf("TransformTranslate error %v", err)
+	}
+	assert.True(t, res.(*geometry.Geometry) == g)
+	assert.Equal(t, g.Coordinates, []float64{0, 0, 150})
+
+	_, err = TransformTranslate(g, 10, 0, TranslateOptions{Units: "parsecs"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,55 @@
+package transformation
+
+import (
+	"github.com/tomchavakis/geojson/geometry"
+	"github.com/tomchavakis/turf-go/constants"
+	"github.com/tomchavakis/turf-go/conversions"
+)
+
+// TranslateOptions ...
+type TranslateOptions struct {
+	// Units of the distance. constants.UnitKilometers is the default value
+	Units string
+	// ZTranslation is the distance added to the altitude of the positions that have one. 0 is the default value
+	ZTranslation float64
+	// Mutate allows the input GeoJSON to be changed in place, otherwise a clone is translated. false is the default value
+	Mutate bool
+}
+
+// TransformTranslate moves any GeoJSON object the given distance along a rhumb line on the given direction.
+// The direction is in decimal degrees from north, positive clockwise. A negative distance moves the object
+// on the opposite direction. geojson can be a FeatureCollection, Feature, GeometryCollection, Geometry or any geometry type
+// and the returned object has the same type.
+//
+// Examples:
+//
+//	res, err := transformation.TransformTranslate(poly, 100, 35, TranslateOptions{})
+//	moved := res.(*geometry.Polygon)
+func TransformTranslate(geojson interface{}, distance float64, direction float64, options TranslateOptions) (interface{}, error) {
+	if options.Units == "" {
+		options.Units = constants.UnitKilometers
+	}
+	if distance < 0 {
+		distance = -distance
+		direction += 180
+	}
+
+	meters, err := conversions.ConvertLength(distance, options.Units, constants.UnitMeters)
+	if err != nil {
+		return nil, err
+	}
+
+	return transform(geojson, options.Mutate, func(pos []float64) ([]float64, error) {
+		if distance == 0 && options.ZTranslation == 0 {
+			return pos, nil
+		}
+		res, err := rhumbPosition(geometry.Point{Lng: pos[0], Lat: pos[1]}, meters, direction, pos)
+		if err != nil {
+			return nil, err
+		}
+		if len(res) > 2 {
+			res[2] += options.ZTranslation
+		}
+		return res, nil
+	})
+}